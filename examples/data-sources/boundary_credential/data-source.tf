@@ -0,0 +1,20 @@
+data "boundary_scope" "org" {
+  name     = "org"
+  scope_id = "global"
+}
+
+data "boundary_scope" "project" {
+  name     = "project"
+  scope_id = data.boundary_scope.org.id
+}
+
+data "boundary_credential_store" "static" {
+  name     = "static"
+  scope_id = data.boundary_scope.project.id
+}
+
+# Retrieve a credential from a static credential store
+data "boundary_credential" "admin" {
+  name                = "admin"
+  credential_store_id = data.boundary_credential_store.static.id
+}
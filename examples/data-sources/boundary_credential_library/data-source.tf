@@ -0,0 +1,20 @@
+data "boundary_scope" "org" {
+  name     = "org"
+  scope_id = "global"
+}
+
+data "boundary_scope" "project" {
+  name     = "project"
+  scope_id = data.boundary_scope.org.id
+}
+
+data "boundary_credential_store" "vault" {
+  name     = "vault"
+  scope_id = data.boundary_scope.project.id
+}
+
+# Retrieve a credential library from a credential store
+data "boundary_credential_library" "database" {
+  name                = "database"
+  credential_store_id = data.boundary_credential_store.vault.id
+}
@@ -0,0 +1,15 @@
+data "boundary_scope" "org" {
+  name     = "org"
+  scope_id = "global"
+}
+
+data "boundary_scope" "project" {
+  name     = "project"
+  scope_id = data.boundary_scope.org.id
+}
+
+# Retrieve a credential store from a project scope
+data "boundary_credential_store" "vault" {
+  name     = "vault"
+  scope_id = data.boundary_scope.project.id
+}
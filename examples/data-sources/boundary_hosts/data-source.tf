@@ -0,0 +1,38 @@
+resource "boundary_host_catalog_plugin" "aws" {
+  name        = "aws"
+  scope_id    = "p_1234567890"
+  plugin_name = "aws"
+  attributes_json = jsonencode({
+    "region"                      = "us-east-1",
+    "disable_credential_rotation" = true
+  })
+  secrets_json = jsonencode({
+    "access_key_id"     = "aws_access_key_id_value",
+    "secret_access_key" = "aws_secret_access_key_value"
+  })
+}
+
+resource "boundary_host_set_plugin" "web" {
+  name            = "web"
+  host_catalog_id = boundary_host_catalog_plugin.aws.id
+  attributes_json = jsonencode({
+    "filters" = "tag:service-type=web"
+  })
+}
+
+# The hosts the AWS plugin discovered for the web host set
+data "boundary_hosts" "web" {
+  host_catalog_id = boundary_host_catalog_plugin.aws.id
+  host_set_id     = boundary_host_set_plugin.web.id
+}
+
+check "web_hosts_discovered" {
+  assert {
+    condition     = length(data.boundary_hosts.web.ids) >= 3
+    error_message = "The web host set filters discovered fewer than 3 instances."
+  }
+}
+
+output "web_private_ips" {
+  value = flatten([for h in data.boundary_hosts.web.hosts : h.ip_addresses])
+}
@@ -0,0 +1,14 @@
+data "boundary_user" "alice" {
+  name     = "alice"
+  scope_id = "o_1234567890"
+}
+
+# Retrieve the fleet aliases Alice can resolve
+data "boundary_resolvable_aliases" "alice" {
+  user_id = data.boundary_user.alice.id
+  filter  = "\"/item/value\" matches \"\\.fleet\\.boundary$\""
+}
+
+output "alice_fleet_aliases" {
+  value = data.boundary_resolvable_aliases.alice.values
+}
@@ -0,0 +1,13 @@
+# List the session recordings of a target across the whole installation
+data "boundary_session_recordings" "ssh" {
+  scope_id  = "global"
+  recursive = true
+  target_id = "tssh_1234567890"
+}
+
+output "recordings_to_delete_soon" {
+  value = [
+    for r in data.boundary_session_recordings.ssh.session_recordings : r.id
+    if r.delete_after != "" && timecmp(r.delete_after, timeadd(plantimestamp(), "720h")) < 0
+  ]
+}
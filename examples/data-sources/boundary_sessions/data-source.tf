@@ -0,0 +1,15 @@
+# List the active sessions of a target across an org and its projects
+data "boundary_sessions" "active" {
+  scope_id  = "o_1234567890"
+  recursive = true
+  status    = "active"
+  filter    = "\"/item/target_id\" == \"ttcp_1234567890\""
+}
+
+# Refuse to go on while sessions are active on the target
+check "no_active_sessions" {
+  assert {
+    condition     = length(data.boundary_sessions.active.ids) == 0
+    error_message = "Target ttcp_1234567890 still has active sessions."
+  }
+}
@@ -0,0 +1,5 @@
+# The hosts of the catalog whose name starts with "web-"
+data "boundary_hosts" "web" {
+  host_catalog_id = boundary_host_catalog_plugin.aws.id
+  filter          = provider::boundary::filter_name_matches("^web-")
+}
@@ -0,0 +1,8 @@
+resource "boundary_role" "readonly" {
+  name     = "readonly"
+  scope_id = boundary_scope.project.id
+  grant_strings = [
+    provider::boundary::grant(["*"], "target", ["read", "authorize-session"]),
+    provider::boundary::grant(["*"], "session", ["list", "read"], "id", "target_id", "status"),
+  ]
+}
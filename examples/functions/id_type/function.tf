@@ -0,0 +1,4 @@
+output "auth_method_type" {
+  # "boundary_auth_method_password"
+  value = provider::boundary::id_type(boundary_auth_method_password.password.id)
+}
@@ -0,0 +1,8 @@
+locals {
+  grant = provider::boundary::parse_grant("ids=*;type=target;actions=read,authorize-session")
+}
+
+output "grant_actions" {
+  # ["read", "authorize-session"]
+  value = local.grant.actions
+}
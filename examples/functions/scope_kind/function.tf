@@ -0,0 +1,4 @@
+output "scope_kind" {
+  # "global", "org" or "project"
+  value = provider::boundary::scope_kind(var.scope_id)
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_account_ldap.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
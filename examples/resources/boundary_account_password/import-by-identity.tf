@@ -0,0 +1,6 @@
+import {
+  to = boundary_account_password.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_alias_target.example_alias_target
+  identity = {
+    id = "<my-id>"
+  }
+}
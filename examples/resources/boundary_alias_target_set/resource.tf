@@ -0,0 +1,51 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "global scope"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_host_catalog_static" "fleet" {
+  name     = "fleet"
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_host_static" "fleet" {
+  for_each        = toset(["web-1", "web-2", "web-3"])
+  name            = each.key
+  host_catalog_id = boundary_host_catalog_static.fleet.id
+  address         = "${each.key}.internal"
+}
+
+resource "boundary_host_set_static" "fleet" {
+  name            = "fleet"
+  host_catalog_id = boundary_host_catalog_static.fleet.id
+  host_ids        = [for h in boundary_host_static.fleet : h.id]
+}
+
+resource "boundary_target" "ssh" {
+  name            = "fleet-ssh"
+  type            = "tcp"
+  default_port    = "22"
+  scope_id        = boundary_scope.project.id
+  host_source_ids = [boundary_host_set_static.fleet.id]
+}
+
+# One DNS-style alias per host, each connecting to that host through the target
+resource "boundary_alias_target_set" "fleet" {
+  scope_id = "global"
+  aliases = {
+    for name, h in boundary_host_static.fleet : "${name}.fleet.boundary" => boundary_target.ssh.id
+  }
+  authorize_session_host_ids = {
+    for name, h in boundary_host_static.fleet : "${name}.fleet.boundary" => h.id
+  }
+}
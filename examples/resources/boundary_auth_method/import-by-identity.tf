@@ -0,0 +1,6 @@
+import {
+  to = boundary_auth_method.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_json.example_json
+  identity = {
+    id = "<my-id>"
+  }
+}
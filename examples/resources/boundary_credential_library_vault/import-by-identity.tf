@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_library_vault.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
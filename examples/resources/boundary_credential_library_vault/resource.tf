@@ -68,3 +68,16 @@ resource "boundary_credential_library_vault" "quz" {
     username_attribute               = "alternative_username_label"
   }
 }
+
+resource "boundary_credential_library_vault" "kv" {
+  name                = "kv"
+  description         = "vault username password credential read from a KV v2 secret"
+  credential_store_id = boundary_credential_store_vault.foo.id
+  credential_type     = "username_password"
+
+  # reads from the secret/data/app/db path
+  kv_v2 {
+    mount       = "secret"
+    secret_path = "app/db"
+  }
+}
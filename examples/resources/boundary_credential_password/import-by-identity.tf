@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_password.example
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_ssh_private_key.example_ssh_private_key
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_store_static.example_static_credential_store
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -20,3 +20,21 @@ resource "boundary_credential_store_vault" "example" {
   token       = "s.0ufRo6XEGU2jOqnIr7OlFYP5" # change to valid Vault token
   scope_id    = boundary_scope.project.id
 }
+
+# The token can also be passed as a write-only argument, which keeps it out of
+# state and accepts ephemeral values. Increment token_wo_version whenever the
+# token is re-issued to send the new token to Boundary.
+variable "vault_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "boundary_credential_store_vault" "write_only_token" {
+  name             = "bar"
+  description      = "Vault credential store with a write-only token"
+  address          = "http://127.0.0.1:8200" # change to Vault address
+  token_wo         = var.vault_token
+  token_wo_version = 1
+  scope_id         = boundary_scope.project.id
+}
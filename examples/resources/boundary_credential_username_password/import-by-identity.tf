@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_username_password.example_username_password
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_credential_username_password_domain.example_username_password_domain
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_group.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_host.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_host_catalog_plugin.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_host_set_plugin.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
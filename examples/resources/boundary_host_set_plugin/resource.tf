@@ -55,6 +55,13 @@ resource "boundary_host_set_plugin" "launch" {
   attributes_json = jsonencode({
     "filters" = ["tag:development=prod,dev", "launch-time=2022-01-04T*"]
   })
+
+  # wait for the first sync to find at least two instances before
+  # resources depending on this host set are created
+  wait_for_sync {
+    timeout   = "5m"
+    min_hosts = 2
+  }
 }
 
 # For more information about the azure plugin, please visit here:
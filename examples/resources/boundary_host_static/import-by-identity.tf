@@ -0,0 +1,6 @@
+import {
+  to = boundary_host_static.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
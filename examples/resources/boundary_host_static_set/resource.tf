@@ -0,0 +1,45 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_host_catalog_static" "fleet" {
+  name        = "fleet"
+  description = "Hosts of the web fleet"
+  scope_id    = boundary_scope.project.id
+}
+
+# Hosts from an inventory, e.g. csvdecode(file("inventory.csv")) or the
+# output of another module
+locals {
+  inventory = {
+    "web-1" = "10.0.0.1"
+    "web-2" = "10.0.0.2"
+    "web-3" = "10.0.0.3"
+  }
+}
+
+resource "boundary_host_static_set" "fleet" {
+  host_catalog_id = boundary_host_catalog_static.fleet.id
+  hosts           = local.inventory
+  parallelism     = 4
+  host_set_name   = "web"
+}
+
+resource "boundary_target" "web" {
+  name            = "web"
+  type            = "tcp"
+  default_port    = "22"
+  scope_id        = boundary_scope.project.id
+  host_source_ids = [boundary_host_static_set.fleet.host_set_id]
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_managed_group_ldap.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
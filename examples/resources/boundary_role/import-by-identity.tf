@@ -0,0 +1,6 @@
+import {
+  to = boundary_role.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
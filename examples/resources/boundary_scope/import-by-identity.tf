@@ -0,0 +1,6 @@
+import {
+  to = boundary_scope.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_scope_alias_suffix.org_suffix
+  identity = {
+    id = "<scope-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_scope_primary_auth_method.org
+  identity = {
+    id = "<scope-id>"
+  }
+}
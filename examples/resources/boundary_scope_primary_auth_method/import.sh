@@ -0,0 +1 @@
+terraform import boundary_scope_primary_auth_method.org <scope-id>
@@ -0,0 +1,23 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_auth_method_password" "password" {
+  scope_id = boundary_scope.org.id
+}
+
+resource "boundary_auth_method_ldap" "ldap" {
+  scope_id = boundary_scope.org.id
+  urls     = ["ldaps://ldap.example.com"]
+}
+
+# Switching auth_method_id from the password auth method to the LDAP one
+# updates the scope in a single request
+resource "boundary_scope_primary_auth_method" "org" {
+  scope_id       = boundary_scope.org.id
+  auth_method_id = boundary_auth_method_ldap.ldap.id
+}
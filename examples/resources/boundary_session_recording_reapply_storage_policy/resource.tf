@@ -0,0 +1,25 @@
+resource "boundary_policy_storage" "compliance" {
+  name            = "compliance"
+  scope_id        = "global"
+  retain_for_days = 365
+}
+
+resource "boundary_scope_policy_attachment" "compliance" {
+  scope_id  = "global"
+  policy_id = boundary_policy_storage.compliance.id
+}
+
+# Reapply the storage policy to the existing recordings whenever the policy
+# changes, and once per quarter
+resource "boundary_session_recording_reapply_storage_policy" "compliance" {
+  scope_id  = "global"
+  recursive = true
+
+  triggers = {
+    policy_id       = boundary_policy_storage.compliance.id
+    retain_for_days = boundary_policy_storage.compliance.retain_for_days
+    quarter         = "2026-Q4"
+  }
+
+  depends_on = [boundary_scope_policy_attachment.compliance]
+}
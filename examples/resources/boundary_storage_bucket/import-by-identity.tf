@@ -0,0 +1,6 @@
+import {
+  to = boundary_storage_bucket.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -39,3 +39,31 @@ resource "boundary_storage_bucket" "aws_dynamic_credentials_example" {
   })
   worker_filter = "\"pki\" in \"/tags/type\""
 }
+
+resource "boundary_storage_bucket" "aws_typed_example" {
+  name        = "My aws storage bucket configured with the aws block"
+  scope_id    = boundary_scope.org.id
+  plugin_name = "aws"
+  bucket_name = "mybucket"
+
+  aws {
+    region                      = "us-east-1"
+    role_arn                    = "arn:aws:iam::123456789012:role/S3Access"
+    disable_credential_rotation = true
+  }
+  worker_filter = "\"pki\" in \"/tags/type\""
+}
+
+resource "boundary_storage_bucket" "minio_example" {
+  name        = "My minio storage bucket"
+  scope_id    = boundary_scope.org.id
+  plugin_name = "minio"
+  bucket_name = "mybucket"
+
+  minio {
+    endpoint_url      = "https://minio.example.com:9000"
+    access_key_id     = "minio_access_key_id_value"
+    secret_access_key = "minio_secret_access_key_value"
+  }
+  worker_filter = "\"pki\" in \"/tags/type\""
+}
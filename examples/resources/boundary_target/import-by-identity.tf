@@ -0,0 +1,6 @@
+import {
+  to = boundary_target.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = boundary_target_credential_source.foo
+  identity = {
+    target_id            = "<target-id>"
+    credential_source_id = "<credential-source-id>"
+  }
+}
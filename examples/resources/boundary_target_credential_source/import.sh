@@ -0,0 +1 @@
+terraform import boundary_target_credential_source.foo <target-id>:<credential-source-id>
@@ -0,0 +1,51 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_credential_store_vault" "vault" {
+  name        = "vault"
+  description = "My Vault credential store"
+  address     = "http://127.0.0.1:8200"
+  token       = "s.0ufRo6XEGU2jOqnIr7OlFYP5"
+  scope_id    = boundary_scope.project.id
+}
+
+resource "boundary_credential_library_vault" "database" {
+  name                = "database"
+  description         = "Database credentials"
+  credential_store_id = boundary_credential_store_vault.vault.id
+  path                = "database/creds/readonly"
+  http_method         = "GET"
+}
+
+resource "boundary_target" "foo" {
+  name         = "foo"
+  type         = "tcp"
+  default_port = "5432"
+  scope_id     = boundary_scope.project.id
+
+  # Credential sources are managed by boundary_target_credential_source
+  lifecycle {
+    ignore_changes = [
+      brokered_credential_source_ids,
+      injected_application_credential_source_ids,
+    ]
+  }
+}
+
+resource "boundary_target_credential_source" "database" {
+  target_id            = boundary_target.foo.id
+  credential_source_id = boundary_credential_library_vault.database.id
+  purpose              = "brokered"
+}
@@ -0,0 +1,7 @@
+import {
+  to = boundary_target_host_source.foo
+  identity = {
+    target_id      = "<target-id>"
+    host_source_id = "<host-source-id>"
+  }
+}
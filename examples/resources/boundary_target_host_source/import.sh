@@ -0,0 +1 @@
+terraform import boundary_target_host_source.foo <target-id>:<host-source-id>
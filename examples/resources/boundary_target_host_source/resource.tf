@@ -0,0 +1,49 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_host_catalog_static" "foo" {
+  name        = "test"
+  description = "test catalog"
+  scope_id    = boundary_scope.project.id
+}
+
+resource "boundary_host_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+  address         = "10.0.0.1"
+}
+
+resource "boundary_host_set_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+  host_ids        = [boundary_host_static.foo.id]
+}
+
+resource "boundary_target" "foo" {
+  name         = "foo"
+  type         = "tcp"
+  default_port = "22"
+  scope_id     = boundary_scope.project.id
+
+  # Host sources are managed by boundary_target_host_source
+  lifecycle {
+    ignore_changes = [host_source_ids]
+  }
+}
+
+resource "boundary_target_host_source" "foo" {
+  target_id      = boundary_target.foo.id
+  host_source_id = boundary_host_set_static.foo.id
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_target_rdp.windows
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1 @@
+terraform import boundary_target_rdp.windows <my-id>
\ No newline at end of file
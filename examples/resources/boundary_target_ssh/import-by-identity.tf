@@ -0,0 +1,6 @@
+import {
+  to = boundary_target_ssh.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1 @@
+terraform import boundary_target_ssh.foo <my-id>
\ No newline at end of file
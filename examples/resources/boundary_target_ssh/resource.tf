@@ -0,0 +1,51 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_credential_store_static" "example" {
+  name        = "example_static_credential_store"
+  description = "My first static credential store!"
+  scope_id    = boundary_scope.project.id
+}
+
+resource "boundary_credential_ssh_private_key" "example" {
+  name                = "example_ssh_private_key"
+  description         = "My first ssh private key credential!"
+  credential_store_id = boundary_credential_store_static.example.id
+  username            = "my-username"
+  private_key         = file("~/.ssh/id_rsa")
+}
+
+resource "boundary_storage_bucket" "example" {
+  name        = "My aws storage bucket"
+  scope_id    = boundary_scope.org.id
+  bucket_name = "mybucket"
+  aws {
+    region            = "us-east-1"
+    access_key_id     = "aws_access_key_id_value"
+    secret_access_key = "aws_secret_access_key_value"
+  }
+  worker_filter = "\"pki\" in \"/tags/type\""
+}
+
+resource "boundary_target_ssh" "foo" {
+  name                                       = "ssh_foo"
+  description                                = "Foo target"
+  scope_id                                   = boundary_scope.project.id
+  address                                    = "10.0.0.1"
+  default_port                               = 22
+  injected_application_credential_source_ids = [boundary_credential_ssh_private_key.example.id]
+  enable_session_recording                   = true
+  storage_bucket_id                          = boundary_storage_bucket.example.id
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_target_tcp.postgres
+  identity = {
+    id = "<my-id>"
+  }
+}
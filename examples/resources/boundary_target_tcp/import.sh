@@ -0,0 +1 @@
+terraform import boundary_target_tcp.postgres <my-id>
\ No newline at end of file
@@ -0,0 +1,30 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_target_tcp" "postgres" {
+  name                     = "postgres"
+  description              = "Postgres target"
+  scope_id                 = boundary_scope.project.id
+  address                  = "10.0.0.1"
+  default_port             = 5432
+  session_connection_limit = -1
+
+  # Wait for active sessions to end for up to 15 minutes before the address
+  # changes or the target is destroyed, then cancel the remaining ones
+  session_handling {
+    mode    = "wait_up_to"
+    timeout = "15m"
+  }
+}
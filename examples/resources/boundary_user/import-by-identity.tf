@@ -0,0 +1,6 @@
+import {
+  to = boundary_user.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = boundary_worker.foo
+  identity = {
+    id = "<my-id>"
+  }
+}
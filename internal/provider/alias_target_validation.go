@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	aliasValueMaxLength      = 253
+	aliasValueLabelMaxLength = 63
+)
+
+var aliasValueLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
+// validateTargetAliasValue checks the alias value is a valid DNS name, which
+// is what Boundary requires of alias values
+func validateTargetAliasValue(value string) error {
+	switch {
+	case value == "":
+		return fmt.Errorf("alias value must not be empty")
+	case len(value) > aliasValueMaxLength:
+		return fmt.Errorf("alias value %q is longer than %d characters", value, aliasValueMaxLength)
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		switch {
+		case label == "":
+			return fmt.Errorf("alias value %q must not start or end with a dot or contain consecutive dots", value)
+		case len(label) > aliasValueLabelMaxLength:
+			return fmt.Errorf("alias value %q has a label longer than %d characters", value, aliasValueLabelMaxLength)
+		case !aliasValueLabelRegexp.MatchString(label):
+			return fmt.Errorf("alias value %q must only contain letters, digits, hyphens and dots, and its labels must not start or end with a hyphen", value)
+		}
+	}
+	return nil
+}
+
+// validateTargetAliasValueFunc is the ValidateFunc version of
+// validateTargetAliasValue
+func validateTargetAliasValueFunc(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if err := validateTargetAliasValue(v); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+// checkTargetAliasCollision returns an error when an alias other than the ones
+// in ownIds already uses one of values. Aliases are unique across all scopes
+// so they are listed recursively from the global scope, once for all values:
+// filtered on the value when there is a single one, unfiltered otherwise. The
+// check is skipped when the provider is not allowed to list aliases.
+func checkTargetAliasCollision(ctx context.Context, md *metaData, values []string, ownIds ...string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	opts := []aliases.Option{aliases.WithRecursive(true)}
+	if len(values) == 1 {
+		opts = append(opts, aliases.WithFilter(fmt.Sprintf(`"/item/value" == %q`, values[0])))
+	}
+	alr, err := aliases.NewClient(md.client).List(ctx, globalScopeId, opts...)
+	if err != nil {
+		if isForbidden(err) {
+			return nil
+		}
+		return fmt.Errorf("error looking up aliases: %w", err)
+	}
+
+	existing := make(map[string]string, len(alr.GetItems()))
+	for _, a := range alr.GetItems() {
+		if !slices.Contains(ownIds, a.Id) {
+			existing[a.Value] = a.Id
+		}
+	}
+	for _, value := range values {
+		if aliasId, ok := existing[value]; ok {
+			return fmt.Errorf("alias value %q is already used by alias %q", value, aliasId)
+		}
+	}
+	return nil
+}
+
+// targetAliasHostChecker checks authorize session hosts against the host
+// sets of the destination targets. The targets and host sets are read once
+// however many aliases refer to them.
+type targetAliasHostChecker struct {
+	md *metaData
+	// hostSourceIds are the host sources of the targets read, nil when the
+	// provider is not allowed to read the target
+	hostSourceIds map[string][]string
+	// hostIds are the hosts of the host sets read, nil when the provider is
+	// not allowed to read the host set
+	hostIds map[string][]string
+}
+
+func newTargetAliasHostChecker(md *metaData) *targetAliasHostChecker {
+	return &targetAliasHostChecker{
+		md:            md,
+		hostSourceIds: make(map[string][]string),
+		hostIds:       make(map[string][]string),
+	}
+}
+
+// check returns an error when the host is not in any of the host sets of the
+// target. The check is skipped when the provider is not allowed to read the
+// target or its host sets.
+func (c *targetAliasHostChecker) check(ctx context.Context, targetId, hostId string) error {
+	hostSourceIds, ok := c.hostSourceIds[targetId]
+	if !ok {
+		trr, err := targets.NewClient(c.md.client).Read(ctx, targetId)
+		switch {
+		case err != nil && isForbidden(err):
+		case err != nil:
+			return fmt.Errorf("error reading destination target %q: %w", targetId, err)
+		case trr == nil || trr.Item == nil:
+			return fmt.Errorf("target nil after read")
+		default:
+			hostSourceIds = trr.Item.HostSourceIds
+			if hostSourceIds == nil {
+				hostSourceIds = []string{}
+			}
+		}
+		c.hostSourceIds[targetId] = hostSourceIds
+	}
+	if hostSourceIds == nil {
+		return nil
+	}
+
+	if len(hostSourceIds) == 0 {
+		return fmt.Errorf("%q is set but destination target %q has no host sources", aliasTargetAuthorizeSessionHostIdKey, targetId)
+	}
+
+	hsc := hostsets.NewClient(c.md.client)
+	for _, hostSetId := range hostSourceIds {
+		hostIds, ok := c.hostIds[hostSetId]
+		if !ok {
+			hsrr, err := hsc.Read(ctx, hostSetId)
+			switch {
+			case err != nil && isForbidden(err):
+			case err != nil:
+				return fmt.Errorf("error reading host set %q of destination target %q: %w", hostSetId, targetId, err)
+			case hsrr == nil || hsrr.Item == nil:
+				hostIds = []string{}
+			default:
+				hostIds = hsrr.Item.HostIds
+				if hostIds == nil {
+					hostIds = []string{}
+				}
+			}
+			c.hostIds[hostSetId] = hostIds
+		}
+		if hostIds == nil || slices.Contains(hostIds, hostId) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("host %q is not in any host set of destination target %q", hostId, targetId)
+}
+
+// isForbidden reports whether err is a permission denied error from Boundary
+func isForbidden(err error) bool {
+	apiErr := api.AsServerError(err)
+	return apiErr != nil && apiErr.Response().StatusCode() == http.StatusForbidden
+}
+
+// resourceAliasTargetCustomizeDiff validates the value and authorize session
+// host of a boundary_alias_target against the aliases and targets that
+// already exist, so conflicts are reported at plan time
+func resourceAliasTargetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	md, ok := meta.(*metaData)
+	if !ok || md == nil || md.client == nil {
+		return nil
+	}
+
+	if d.HasChange(ValueKey) && d.NewValueKnown(ValueKey) {
+		if err := checkTargetAliasCollision(ctx, md, []string{d.Get(ValueKey).(string)}, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges(DestinationIdKey, aliasTargetAuthorizeSessionHostIdKey) &&
+		d.NewValueKnown(DestinationIdKey) && d.NewValueKnown(aliasTargetAuthorizeSessionHostIdKey) {
+		targetId := d.Get(DestinationIdKey).(string)
+		hostId := d.Get(aliasTargetAuthorizeSessionHostIdKey).(string)
+		if targetId != "" && hostId != "" {
+			if err := newTargetAliasHostChecker(md).check(ctx, targetId, hostId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAliasTargetSetCustomizeDiff validates the aliases of a
+// boundary_alias_target_set like resourceAliasTargetCustomizeDiff does for a
+// single alias
+func resourceAliasTargetSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateAliasTargetSet(d); err != nil {
+		return err
+	}
+
+	md, ok := meta.(*metaData)
+	if !ok || md == nil || md.client == nil {
+		return nil
+	}
+	if !d.HasChanges(aliasTargetSetAliasesKey, aliasTargetSetAuthorizeSessionHostIdsKey) ||
+		!d.NewValueKnown(aliasTargetSetAliasesKey) || !d.NewValueKnown(aliasTargetSetAuthorizeSessionHostIdsKey) {
+		return nil
+	}
+
+	oldValues, newValues := d.GetChange(aliasTargetSetAliasesKey)
+	oldHostIds, newHostIds := d.GetChange(aliasTargetSetAuthorizeSessionHostIdsKey)
+	current := expandAliasTargetSet(oldValues.(map[string]interface{}), oldHostIds.(map[string]interface{}))
+	desired := expandAliasTargetSet(newValues.(map[string]interface{}), newHostIds.(map[string]interface{}))
+
+	var ownIds []string
+	for _, aliasId := range d.Get(aliasTargetSetAliasIdsKey).(map[string]interface{}) {
+		ownIds = append(ownIds, aliasId.(string))
+	}
+
+	create, update, _ := diffAliasTargetSet(current, desired)
+	if err := checkTargetAliasCollision(ctx, md, create, ownIds...); err != nil {
+		return err
+	}
+	hosts := newTargetAliasHostChecker(md)
+	for _, value := range append(create, update...) {
+		entry := desired[value]
+		if entry.destinationId == "" || entry.hostId == "" {
+			continue
+		}
+		if err := hosts.check(ctx, entry.destinationId, entry.hostId); err != nil {
+			return fmt.Errorf("alias %q: %w", value, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// isNotFoundError reports whether err is the controller answering that the
+// resource does not exist
+func isNotFoundError(err error) bool {
+	apiErr := api.AsServerError(err)
+	return apiErr != nil && apiErr.Response() != nil && apiErr.Response().StatusCode() == http.StatusNotFound
+}
+
+const (
+	// versionConflictKind and versionConflictMessageSuffix identify the not
+	// found error the controller answers an update with when no resource has
+	// the given version, e.g.
+	// `Role "r_1234567890" doesn't exist or incorrect version provided.`
+	versionConflictKind          = "NotFound"
+	versionConflictMessageSuffix = "doesn't exist or incorrect version provided."
+)
+
+// isVersionConflictError reports whether err is the controller rejecting an
+// update because the resource changed since its version was read. Other
+// conflicts, like an alias value already in use, are not version conflicts.
+func isVersionConflictError(err error) bool {
+	apiErr := api.AsServerError(err)
+	if apiErr == nil || apiErr.Response() == nil {
+		return false
+	}
+	return apiErr.Response().StatusCode() == http.StatusNotFound &&
+		apiErr.Kind == versionConflictKind &&
+		strings.HasSuffix(apiErr.Message, versionConflictMessageSuffix)
+}
+
+// retryOnVersionConflict makes call, and makes it once more if the controller
+// rejected it because the resource was changed by someone else in the
+// meantime. call must use automatic versioning with a zero version, so the
+// current version of the resource is read each time.
+func retryOnVersionConflict[T any](ctx context.Context, call func() (T, error)) (T, error) {
+	result, err := call()
+	if err == nil || !isVersionConflictError(err) {
+		return result, err
+	}
+	tflog.Debug(ctx, "Retrying Boundary API request after a version conflict", map[string]interface{}{"error": err.Error()})
+	return call()
+}
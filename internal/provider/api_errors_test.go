@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testApiError returns the error the Boundary API client gives for a
+// response with the given status and body
+func testApiError(t *testing.T, status int, body string) error {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+	client.SetMaxRetries(0)
+
+	_, err = scopes.NewClient(client).Read(context.Background(), "p_1234567890")
+	require.Error(t, err)
+	return err
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isNotFoundError(testApiError(t, http.StatusNotFound, `{"kind":"NotFound","message":"Resource not found."}`)))
+	assert.False(t, isNotFoundError(testApiError(t, http.StatusForbidden, `{"kind":"PermissionDenied","message":"Forbidden."}`)))
+	assert.False(t, isNotFoundError(errors.New("not found")))
+	assert.False(t, isNotFoundError(nil))
+}
+
+func TestIsVersionConflictError(t *testing.T) {
+	t.Parallel()
+
+	// the answer of the controller to an update with an outdated version
+	assert.True(t, isVersionConflictError(testApiError(t, http.StatusNotFound,
+		`{"kind":"NotFound","message":"Role \"r_1234567890\" doesn't exist or incorrect version provided."}`)))
+	assert.True(t, isVersionConflictError(testApiError(t, http.StatusNotFound,
+		`{"kind":"NotFound","message":"Target \"ttcp_1234567890\" doesn't exist or incorrect version provided."}`)))
+	// Other errors are not fixed by retrying
+	assert.False(t, isVersionConflictError(testApiError(t, http.StatusNotFound, `{"kind":"NotFound","message":"Resource not found."}`)))
+	assert.False(t, isVersionConflictError(testApiError(t, http.StatusConflict, `{"kind":"AlreadyExists","message":"Alias value \"db.example\" is already in use."}`)))
+	assert.False(t, isVersionConflictError(testApiError(t, http.StatusBadRequest, `{"kind":"InvalidArgument","message":"Error in provided request."}`)))
+	assert.False(t, isVersionConflictError(errors.New("Role \"r_1234567890\" doesn't exist or incorrect version provided.")))
+}
+
+func TestRetryOnVersionConflict(t *testing.T) {
+	t.Parallel()
+
+	conflict := testApiError(t, http.StatusNotFound, `{"kind":"NotFound","message":"Scope \"p_1234567890\" doesn't exist or incorrect version provided."}`)
+	other := testApiError(t, http.StatusConflict, `{"kind":"AlreadyExists","message":"Conflict."}`)
+
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			errs:      []error{nil},
+			wantCalls: 1,
+		},
+		{
+			name:      "conflict then success",
+			errs:      []error{conflict, nil},
+			wantCalls: 2,
+		},
+		{
+			name:      "conflict twice",
+			errs:      []error{conflict, conflict},
+			wantCalls: 2,
+			wantErr:   conflict,
+		},
+		{
+			name:      "other error",
+			errs:      []error{other},
+			wantCalls: 1,
+			wantErr:   other,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var calls int
+			result, err := retryOnVersionConflict(context.Background(), func() (int, error) {
+				err := tt.errs[calls]
+				calls++
+				return calls, err
+			})
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantCalls, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
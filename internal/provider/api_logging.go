@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// apiLogSubsystem is the tflog subsystem Boundary API requests are logged
+	// under, its level can be set with TF_LOG_PROVIDER_BOUNDARY_API
+	apiLogSubsystem = "boundary_api"
+
+	// apiRequestIdHeader carries the ID of each request to the controller so
+	// the provider logs can be matched with the controller events
+	apiRequestIdHeader = "X-Correlation-Id"
+
+	apiLogRedacted = "***"
+)
+
+// apiLogSensitiveKeys are the body fields whose value is never logged, on top
+// of the ones matched by apiLogSensitiveKeyParts
+var apiLogSensitiveKeys = []string{
+	"object",
+	"client_certificate_key",
+}
+
+// apiLogSensitiveKeyParts mask any body field whose name contains them
+var apiLogSensitiveKeyParts = []string{
+	"password",
+	"secret",
+	"token",
+	"private_key",
+}
+
+// apiLoggingTransport logs every request made to the Boundary API with tflog.
+// Request and response bodies are only logged at the trace level, with their
+// sensitive fields redacted.
+type apiLoggingTransport struct {
+	transport http.RoundTripper
+}
+
+func newApiLoggingTransport(transport http.RoundTripper) http.RoundTripper {
+	return &apiLoggingTransport{transport: transport}
+}
+
+func (t *apiLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), apiLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_BOUNDARY", "API"))
+
+	requestId, err := newApiRequestId()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(apiRequestIdHeader, requestId)
+
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"request_id": requestId,
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields["duration"] = time.Since(start).String()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "Boundary API request failed", fields)
+		return resp, err
+	}
+
+	var respBody []byte
+	if resp.Body != nil {
+		respBody, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if err != nil {
+			return resp, fmt.Errorf("error reading response body: %w", err)
+		}
+	}
+
+	fields["status"] = resp.StatusCode
+	if id := resp.Header.Get(apiRequestIdHeader); id != "" && id != requestId {
+		fields["controller_request_id"] = id
+	}
+	if version, ok := apiBodyVersion(respBody); ok {
+		fields["version"] = version
+	} else if version, ok := apiBodyVersion(reqBody); ok {
+		fields["version"] = version
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Boundary API request", fields)
+
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "Boundary API request bodies", map[string]interface{}{
+		"request_id":    requestId,
+		"request_body":  redactApiBody(reqBody),
+		"response_body": redactApiBody(respBody),
+	})
+
+	return resp, nil
+}
+
+// newApiRequestId returns a random ID for a request
+func newApiRequestId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating request ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// apiBodyVersion returns the resource version found in a JSON body, if any
+func apiBodyVersion(body []byte) (uint64, bool) {
+	var v struct {
+		Version *uint64 `json:"version"`
+	}
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil || v.Version == nil {
+		return 0, false
+	}
+	return *v.Version, true
+}
+
+// redactApiBody returns the JSON body with the values of its sensitive fields
+// replaced. Bodies that are not JSON are not logged since they cannot be
+// redacted.
+func redactApiBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON data>", len(body))
+	}
+	redacted, err := json.Marshal(redactApiValue(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON data>", len(body))
+	}
+	return string(redacted)
+}
+
+func redactApiValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveApiField(key) {
+				v[key] = apiLogRedacted
+				continue
+			}
+			v[key] = redactApiValue(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactApiValue(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// isSensitiveApiField reports whether the value of a body field must not be
+// logged
+func isSensitiveApiField(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range apiLogSensitiveKeys {
+		if key == sensitive {
+			return true
+		}
+	}
+	for _, part := range apiLogSensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
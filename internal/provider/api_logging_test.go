@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactApiBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "not json",
+			body: "password=foo",
+			want: "<12 bytes of non-JSON data>",
+		},
+		{
+			name: "nested",
+			body: `{"name":"foo","version":2,"attributes":{"login_name":"admin","password":"hunter2"},` +
+				`"secrets":{"access_key_id":"id"},"items":[{"token":"at_1234_secret","id":"at_1234"}],` +
+				`"private_key_passphrase":"pass","object":{"k":"v"}}`,
+			want: `{"attributes":{"login_name":"admin","password":"***"},"items":[{"id":"at_1234","token":"***"}],` +
+				`"name":"foo","object":"***","private_key_passphrase":"***","secrets":"***","version":2}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, redactApiBody([]byte(tt.body)))
+		})
+	}
+}
+
+func TestApiLoggingTransport(t *testing.T) {
+	t.Parallel()
+
+	var gotRequestId, gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestId = r.Header.Get(apiRequestIdHeader)
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{"id":"hst_1234","version":3}`))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newApiLoggingTransport(http.DefaultTransport)}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, srv.URL+"/v1/hosts/hst_1234", strings.NewReader(`{"version":2}`))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// Bodies are passed through untouched
+	assert.Equal(t, `{"version":2}`, gotBody)
+	assert.Equal(t, `{"id":"hst_1234","version":3}`, string(body))
+	assert.Len(t, gotRequestId, 32)
+	assert.Empty(t, req.Header.Get(apiRequestIdHeader), "the original request must not be modified")
+
+	version, ok := apiBodyVersion(body)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), version)
+}
@@ -60,4 +60,14 @@ const (
 	ValueKey = "value"
 	// DestinationIdKey is used for common "destination_id" resource attribute
 	DestinationIdKey = "destination_id"
+	// TargetIdKey is used for common "target_id" resource attribute
+	TargetIdKey = "target_id"
+	// UserIdKey is used for common "user_id" resource attribute
+	UserIdKey = "user_id"
+	// FilterKey is used for common "filter" data source attribute
+	FilterKey = "filter"
+	// RecursiveKey is used for common "recursive" data source attribute
+	RecursiveKey = "recursive"
+	// IdsKey is used for common "ids" data source attribute
+	IdsKey = "ids"
 )
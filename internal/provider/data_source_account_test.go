@@ -42,7 +42,7 @@ func TestAccAccountReadPassword(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, fooAccountPassword, accountPasswordRead),
@@ -69,7 +69,7 @@ func TestAccAccountReadLdap(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, testAccountLdap, accountLdapRead),
@@ -100,7 +100,7 @@ func TestAccAccountReadOidc(t *testing.T) {
 	createConfig := fmt.Sprintf(fooAccountOidc, tp.Addr(), tpCert, fooAccountOidcDesc, tp.ExpectedSubject(), tp.Addr())
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, createConfig, accountOidcRead),
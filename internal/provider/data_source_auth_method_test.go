@@ -52,7 +52,7 @@ func TestAccAuthMethodReadGlobal(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, authMethodReadGlobal),
@@ -78,7 +78,7 @@ func TestAccAuthMethodReadOrg(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, authMethodReadOrg),
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCredential() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_credential data source allows you to find a Boundary static credential. " +
+			"Secret values are never returned, only their HMAC.",
+		ReadContext: dataSourceCredentialRead,
+
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Description:  "The name of the credential to retrieve.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			credentialStoreIdKey: {
+				Description:  "The ID of the credential store the credential is in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			IDKey: {
+				Description: "The ID of the retrieved credential.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			DescriptionKey: {
+				Description: "The description of the retrieved credential.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			TypeKey: {
+				Description: "The type of the retrieved credential.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialUsernamePasswordUsernameKey: {
+				Description: "The username of the credential, if the credential type has one.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialUsernamePasswordDomainDomainKey: {
+				Description: "The domain of the credential, only set for `username_password_domain` credentials.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialUsernamePasswordPasswordHmacKey: {
+				Description: "The HMAC of the password, if the credential type has one.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialSshPrivateKeyPrivateKeyHmacKey: {
+				Description: "The HMAC of the private key, only set for `ssh_private_key` credentials.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialSshPrivateKeyPassphraseHmacKey: {
+				Description: "The HMAC of the private key passphrase, only set for `ssh_private_key` credentials.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialJsonObjectHmacKey: {
+				Description: "The HMAC of the object, only set for `json` credentials.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeKey: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						NameKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						TypeKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						DescriptionKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						ParentScopeIdKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	name := d.Get(NameKey).(string)
+	credentialStoreId := d.Get(credentialStoreIdKey).(string)
+
+	ccl := credentials.NewClient(md.client)
+	credentialsList, err := ccl.List(
+		ctx, credentialStoreId,
+		credentials.WithFilter(FilterWithItemNameMatches(name)),
+	)
+	if err != nil {
+		return diag.Errorf("error calling list credential: %v", err)
+	}
+	creds := credentialsList.GetItems()
+	if creds == nil {
+		return diag.Errorf("no credentials found")
+	}
+	if len(creds) == 0 {
+		return diag.Errorf("no matching credential found")
+	}
+	if len(creds) > 1 {
+		return diag.Errorf("error found more than 1 credential")
+	}
+
+	crr, err := ccl.Read(ctx, creds[0].Id)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read credential: %v", err)
+	}
+	if crr == nil {
+		return diag.Errorf("credential nil after read")
+	}
+
+	if err := setFromCredentialRead(d, *crr.Item); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setFromCredentialRead(d *schema.ResourceData, c credentials.Credential) error {
+	if err := d.Set(NameKey, c.Name); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, c.Description); err != nil {
+		return err
+	}
+	if err := d.Set(credentialStoreIdKey, c.CredentialStoreId); err != nil {
+		return err
+	}
+	if err := d.Set(TypeKey, c.Type); err != nil {
+		return err
+	}
+
+	// Boundary only ever returns the HMAC of secret attributes, so copying
+	// the known attribute names is enough to keep secrets out of state
+	for _, k := range []string{
+		credentialUsernamePasswordUsernameKey,
+		credentialUsernamePasswordDomainDomainKey,
+		credentialUsernamePasswordPasswordHmacKey,
+		credentialSshPrivateKeyPrivateKeyHmacKey,
+		credentialSshPrivateKeyPassphraseHmacKey,
+		credentialJsonObjectHmacKey,
+	} {
+		if err := d.Set(k, c.Attributes[k]); err != nil {
+			return err
+		}
+	}
+
+	d.Set(ScopeKey, flattenScopeInfo(c.Scope))
+	d.SetId(c.Id)
+	return nil
+}
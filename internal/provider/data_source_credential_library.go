@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCredentialLibrary() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_credential_library data source allows you to find a Boundary credential library.",
+		ReadContext: dataSourceCredentialLibraryRead,
+
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Description:  "The name of the credential library to retrieve.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			credentialStoreIdKey: {
+				Description:  "The ID of the credential store the credential library is in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			IDKey: {
+				Description: "The ID of the retrieved credential library.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			DescriptionKey: {
+				Description: "The description of the retrieved credential library.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			TypeKey: {
+				Description: "The type of the retrieved credential library.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialLibraryVaultPathKey: {
+				Description: "The Vault path the credential library reads or issues credentials from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialLibraryVaultHttpMethodKey: {
+				Description: "The HTTP method the credential library uses when communicating with Vault.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialLibraryCredentialTypeKey: {
+				Description: "The type of credential the credential library issues.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeKey: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						NameKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						TypeKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						DescriptionKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						ParentScopeIdKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialLibraryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	name := d.Get(NameKey).(string)
+	credentialStoreId := d.Get(credentialStoreIdKey).(string)
+
+	clcl := credentiallibraries.NewClient(md.client)
+	librariesList, err := clcl.List(
+		ctx, credentialStoreId,
+		credentiallibraries.WithFilter(FilterWithItemNameMatches(name)),
+	)
+	if err != nil {
+		return diag.Errorf("error calling list credential library: %v", err)
+	}
+	libraries := librariesList.GetItems()
+	if libraries == nil {
+		return diag.Errorf("no credential libraries found")
+	}
+	if len(libraries) == 0 {
+		return diag.Errorf("no matching credential library found")
+	}
+	if len(libraries) > 1 {
+		return diag.Errorf("error found more than 1 credential library")
+	}
+
+	clrr, err := clcl.Read(ctx, libraries[0].Id)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read credential library: %v", err)
+	}
+	if clrr == nil {
+		return diag.Errorf("credential library nil after read")
+	}
+
+	if err := setFromCredentialLibraryRead(d, *clrr.Item); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setFromCredentialLibraryRead(d *schema.ResourceData, cl credentiallibraries.CredentialLibrary) error {
+	if err := d.Set(NameKey, cl.Name); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, cl.Description); err != nil {
+		return err
+	}
+	if err := d.Set(credentialStoreIdKey, cl.CredentialStoreId); err != nil {
+		return err
+	}
+	if err := d.Set(TypeKey, cl.Type); err != nil {
+		return err
+	}
+	if err := d.Set(credentialLibraryVaultPathKey, cl.Attributes[credentialLibraryVaultPathKey]); err != nil {
+		return err
+	}
+	if err := d.Set(credentialLibraryVaultHttpMethodKey, cl.Attributes[credentialLibraryVaultHttpMethodKey]); err != nil {
+		return err
+	}
+	if err := d.Set(credentialLibraryCredentialTypeKey, cl.CredentialType); err != nil {
+		return err
+	}
+
+	d.Set(ScopeKey, flattenScopeInfo(cl.Scope))
+	d.SetId(cl.Id)
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const credentialLibraryDataSourceName = "data.boundary_credential_library.library"
+
+var credentialLibraryRead = `
+data "boundary_credential_library" "library" {
+	depends_on          = [boundary_credential_library_vault.example]
+	name                = boundary_credential_library_vault.example.name
+	credential_store_id = boundary_credential_store_vault.example.id
+}`
+
+func TestAccCredentialLibraryRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultCredLibResource, credentialLibraryRead),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(credentialLibraryDataSourceName, IDKey, vaultCredResc, IDKey),
+					resource.TestCheckResourceAttr(credentialLibraryDataSourceName, NameKey, vaultCredLibName),
+					resource.TestCheckResourceAttr(credentialLibraryDataSourceName, DescriptionKey, vaultCredLibDesc),
+					resource.TestCheckResourceAttr(credentialLibraryDataSourceName, TypeKey, credentialLibraryVaultType),
+					resource.TestCheckResourceAttr(credentialLibraryDataSourceName, credentialLibraryVaultPathKey, vaultCredLibPath),
+					resource.TestCheckResourceAttr(credentialLibraryDataSourceName, credentialLibraryVaultHttpMethodKey, vaultCredLibMethodGet),
+					resource.TestCheckResourceAttrPair(credentialLibraryDataSourceName, credentialStoreIdKey, vaultCredStoreResc, IDKey),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCredentialStore() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_credential_store data source allows you to find a Boundary credential store. " +
+			"Only non-secret metadata is exposed, secrets are represented by their HMAC.",
+		ReadContext: dataSourceCredentialStoreRead,
+
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Description:  "The name of the credential store to retrieve.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ScopeIdKey: {
+				Description:  "The ID of the project scope the credential store is in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			IDKey: {
+				Description: "The ID of the retrieved credential store.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			DescriptionKey: {
+				Description: "The description of the retrieved credential store.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			TypeKey: {
+				Description: "The type of the retrieved credential store, either `static` or `vault`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultAddressKey: {
+				Description: "The address of the Vault server, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultNamespaceKey: {
+				Description: "The Vault namespace, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultTlsServerNameKey: {
+				Description: "The name to use as the SNI host when connecting to Vault, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultTlsSkipVerifyKey: {
+				Description: "Whether TLS verification of the Vault server is skipped, only set for `vault` credential stores.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			credentialStoreVaultWorkerFilterKey: {
+				Description: "The worker filter used to reach Vault, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultTokenHmacKey: {
+				Description: "The HMAC of the Vault token, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultTokenStatusKey: {
+				Description: "The status of the Vault token as reported by Boundary, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreVaultClientCertificateKeyHmacKey: {
+				Description: "The HMAC of the Vault client certificate key, only set for `vault` credential stores.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeKey: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						NameKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						TypeKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						DescriptionKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						ParentScopeIdKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	name := d.Get(NameKey).(string)
+	scopeId := d.Get(ScopeIdKey).(string)
+
+	cscl := credentialstores.NewClient(md.client)
+	storesList, err := cscl.List(
+		ctx, scopeId,
+		credentialstores.WithFilter(FilterWithItemNameMatches(name)),
+	)
+	if err != nil {
+		return diag.Errorf("error calling list credential store: %v", err)
+	}
+	stores := storesList.GetItems()
+	if stores == nil {
+		return diag.Errorf("no credential stores found")
+	}
+	if len(stores) == 0 {
+		return diag.Errorf("no matching credential store found")
+	}
+	if len(stores) > 1 {
+		return diag.Errorf("error found more than 1 credential store")
+	}
+
+	csrr, err := cscl.Read(ctx, stores[0].Id)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read credential store: %v", err)
+	}
+	if csrr == nil {
+		return diag.Errorf("credential store nil after read")
+	}
+
+	if err := setFromCredentialStoreRead(d, *csrr.Item); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setFromCredentialStoreRead(d *schema.ResourceData, cs credentialstores.CredentialStore) error {
+	if err := d.Set(NameKey, cs.Name); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, cs.Description); err != nil {
+		return err
+	}
+	if err := d.Set(ScopeIdKey, cs.ScopeId); err != nil {
+		return err
+	}
+	if err := d.Set(TypeKey, cs.Type); err != nil {
+		return err
+	}
+
+	// Only non-secret attributes are copied, the static credential store has
+	// no attributes so these are left empty for it
+	for _, k := range []string{
+		credentialStoreVaultAddressKey,
+		credentialStoreVaultNamespaceKey,
+		credentialStoreVaultTlsServerNameKey,
+		credentialStoreVaultTlsSkipVerifyKey,
+		credentialStoreVaultWorkerFilterKey,
+		credentialStoreVaultTokenHmacKey,
+		credentialStoreVaultTokenStatusKey,
+		credentialStoreVaultClientCertificateKeyHmacKey,
+	} {
+		if err := d.Set(k, cs.Attributes[k]); err != nil {
+			return err
+		}
+	}
+
+	d.Set(ScopeKey, flattenScopeInfo(cs.Scope))
+	d.SetId(cs.Id)
+	return nil
+}
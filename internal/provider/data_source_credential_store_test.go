@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const credentialStoreDataSourceName = "data.boundary_credential_store.store"
+
+var credentialStoreReadVault = `
+data "boundary_credential_store" "store" {
+	depends_on = [boundary_credential_store_vault.example]
+	name       = boundary_credential_store_vault.example.name
+	scope_id   = boundary_scope.proj1.id
+}`
+
+func TestAccCredentialStoreReadVault(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, credentialStoreReadVault),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(credentialStoreDataSourceName, IDKey, vaultCredStoreResc, IDKey),
+					resource.TestCheckResourceAttr(credentialStoreDataSourceName, NameKey, vaultCredStoreName),
+					resource.TestCheckResourceAttr(credentialStoreDataSourceName, DescriptionKey, vaultCredStoreDesc),
+					resource.TestCheckResourceAttr(credentialStoreDataSourceName, TypeKey, credentialStoreType),
+					resource.TestCheckResourceAttr(credentialStoreDataSourceName, credentialStoreVaultAddressKey, vc.Addr),
+					resource.TestCheckResourceAttr(credentialStoreDataSourceName, credentialStoreVaultNamespaceKey, vaultCredStoreNamespace),
+					resource.TestCheckResourceAttrPair(credentialStoreDataSourceName, credentialStoreVaultTokenHmacKey, vaultCredStoreResc, credentialStoreVaultTokenHmacKey),
+					resource.TestCheckNoResourceAttr(credentialStoreDataSourceName, credentialStoreVaultTokenKey),
+					resource.TestCheckResourceAttrPair(credentialStoreDataSourceName, "scope.0.id", "boundary_scope.proj1", IDKey),
+				),
+			},
+		},
+	})
+}
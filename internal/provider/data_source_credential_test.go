@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const credentialDataSourceName = "data.boundary_credential.credential"
+
+var credentialRead = `
+data "boundary_credential" "credential" {
+	depends_on          = [boundary_credential_username_password.example]
+	name                = boundary_credential_username_password.example.name
+	credential_store_id = boundary_credential_store_static.example.id
+}`
+
+func TestAccCredentialRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	res := usernamePasswordCredResource(
+		usernamePasswordCredName,
+		usernamePasswordCredDesc,
+		usernamePasswordCredUsername,
+		usernamePasswordCredPassword,
+	)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, res, credentialRead),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(credentialDataSourceName, IDKey, usernamePasswordCredResc, IDKey),
+					resource.TestCheckResourceAttr(credentialDataSourceName, NameKey, usernamePasswordCredName),
+					resource.TestCheckResourceAttr(credentialDataSourceName, DescriptionKey, usernamePasswordCredDesc),
+					resource.TestCheckResourceAttr(credentialDataSourceName, TypeKey, credentialUsernamePasswordCredentialType),
+					resource.TestCheckResourceAttr(credentialDataSourceName, credentialUsernamePasswordUsernameKey, usernamePasswordCredUsername),
+					resource.TestCheckResourceAttrPair(credentialDataSourceName, credentialUsernamePasswordPasswordHmacKey, usernamePasswordCredResc, credentialUsernamePasswordPasswordHmacKey),
+					resource.TestCheckNoResourceAttr(credentialDataSourceName, credentialUsernamePasswordPasswordKey),
+				),
+			},
+		},
+	})
+}
@@ -65,7 +65,7 @@ func TestAccGroupReadGlobal(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, groupReadGlobal),
@@ -92,7 +92,7 @@ func TestAccGroupReadOrg(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, groupReadOrg),
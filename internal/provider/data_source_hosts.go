@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hostsHostSetIdKey   = "host_set_id"
+	hostsKey            = "hosts"
+	hostExternalIdKey   = "external_id"
+	hostExternalNameKey = "external_name"
+	hostIpAddressesKey  = "ip_addresses"
+	hostDnsNamesKey     = "dns_names"
+	hostHostSetIdsKey   = "host_set_ids"
+)
+
+func dataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_hosts data source allows you to list the hosts of a Boundary host catalog, " +
+			"optionally only those of one of its host sets. For plugin host catalogs these are the hosts " +
+			"discovered by the plugin during the last sync.",
+		ReadContext: dataSourceHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			HostCatalogIdKey: {
+				Description:  "The ID of the host catalog to list the hosts of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			hostsHostSetIdKey: {
+				Description: "Only return the hosts that are members of this host set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			FilterKey: {
+				Description: "A Boundary filter expression the hosts must match, " +
+					"e.g. `\"/item/external_name\" matches \"^web-\"`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			IdsKey: {
+				Description: "The IDs of the hosts found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			hostsKey: {
+				Description: "The hosts found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the host.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the host.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the host, e.g. `static` or `plugin`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostAddressKey: {
+							Description: "The address of the host, only set for static hosts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostExternalIdKey: {
+							Description: "The ID of the host in the external system, e.g. the AWS instance ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostExternalNameKey: {
+							Description: "The name of the host in the external system.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostIpAddressesKey: {
+							Description: "The IP addresses of the host.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						hostDnsNamesKey: {
+							Description: "The DNS names of the host.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						hostHostSetIdsKey: {
+							Description: "The IDs of the host sets the host is a member of.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	hc := hosts.NewClient(md.client)
+
+	catalogId := d.Get(HostCatalogIdKey).(string)
+	hostSetId := d.Get(hostsHostSetIdKey).(string)
+
+	opts := []hosts.Option{}
+	if filter := d.Get(FilterKey).(string); filter != "" {
+		opts = append(opts, hosts.WithFilter(filter))
+	}
+
+	hlr, err := hc.List(ctx, catalogId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing hosts: %v", err)
+	}
+	if hlr == nil {
+		return diag.Errorf("no hosts list returned")
+	}
+
+	ids := []string{}
+	items := []interface{}{}
+	for _, h := range hlr.GetItems() {
+		if hostSetId != "" && !slices.Contains(h.HostSetIds, hostSetId) {
+			continue
+		}
+		item, err := flattenHost(h)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ids = append(ids, h.Id)
+		items = append(items, item)
+	}
+
+	if err := d.Set(IdsKey, ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(hostsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if hostSetId != "" {
+		d.SetId(hostSetId)
+	} else {
+		d.SetId(catalogId)
+	}
+	return nil
+}
+
+func flattenHost(h *hosts.Host) (map[string]interface{}, error) {
+	var address string
+	if h.Type == hostTypeStatic {
+		attrs, err := h.GetStaticHostAttributes()
+		if err != nil {
+			return nil, err
+		}
+		address = attrs.Address
+	}
+
+	return map[string]interface{}{
+		IDKey:               h.Id,
+		NameKey:             h.Name,
+		TypeKey:             h.Type,
+		hostAddressKey:      address,
+		hostExternalIdKey:   h.ExternalId,
+		hostExternalNameKey: h.ExternalName,
+		hostIpAddressesKey:  h.IpAddresses,
+		hostDnsNamesKey:     h.DnsNames,
+		hostHostSetIdsKey:   h.HostSetIds,
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const hostsDataSourceName = "data.boundary_hosts.fleet"
+
+var hostsDataSource = `
+resource "boundary_host_static" "outside" {
+	name            = "outside"
+	host_catalog_id = boundary_host_catalog_static.fleet.id
+	address         = "10.0.2.1"
+}
+
+data "boundary_hosts" "fleet" {
+	host_catalog_id = boundary_host_catalog_static.fleet.id
+	host_set_id     = boundary_host_static_set.fleet.host_set_id
+	filter          = "\"/item/name\" matches \"^web-[1-3]$\""
+	depends_on      = [boundary_host_static.outside]
+}
+
+data "boundary_hosts" "catalog" {
+	host_catalog_id = boundary_host_catalog_static.fleet.id
+	depends_on      = [boundary_host_static.outside, boundary_host_static_set.fleet]
+}`
+
+func TestAccHostsRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, hostStaticSetCatalog, hostStaticSet, hostsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(hostsDataSourceName, IDKey, hostStaticSetResc, hostStaticSetHostSetIdKey),
+					resource.TestCheckResourceAttr(hostsDataSourceName, IdsKey+".#", "3"),
+					resource.TestCheckResourceAttr(hostsDataSourceName, hostsKey+".#", "3"),
+					resource.TestCheckResourceAttr(hostsDataSourceName, hostsKey+".0."+TypeKey, hostTypeStatic),
+					resource.TestCheckResourceAttrPair(hostsDataSourceName, hostsKey+".0."+hostHostSetIdsKey+".0", hostStaticSetResc, hostStaticSetHostSetIdKey),
+					resource.TestCheckResourceAttr("data.boundary_hosts.catalog", IdsKey+".#", "6"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	resolvableAliasesValuesKey = "values"
+)
+
+func dataSourceResolvableAliases() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_resolvable_aliases data source allows you to list the aliases a Boundary user " +
+			"can resolve, i.e. the aliases whose destination the user is allowed to connect to.",
+		ReadContext: dataSourceResolvableAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			UserIdKey: {
+				Description:  "The ID of the user to list the resolvable aliases of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			FilterKey: {
+				Description: "A Boundary filter expression the aliases must match, e.g. `\"/item/value\" matches \"\\.prod$\"`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			resolvableAliasesValuesKey: {
+				Description: "The values of the aliases found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			aliasTargetSetAliasesKey: {
+				Description: "The aliases found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope the alias is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ValueKey: {
+							Description: "The value of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DestinationIdKey: {
+							Description: "The ID of the destination of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						aliasTargetAuthorizeSessionHostIdKey: {
+							Description: "The host ID passed to Boundary when performing an authorize session action through the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResolvableAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	uc := users.NewClient(md.client)
+
+	userId := d.Get(UserIdKey).(string)
+
+	opts := []users.Option{}
+	if filter := d.Get(FilterKey).(string); filter != "" {
+		opts = append(opts, users.WithFilter(filter))
+	}
+
+	alr, err := uc.ListResolvableAliases(ctx, userId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing resolvable aliases: %v", err)
+	}
+	if alr == nil {
+		return diag.Errorf("no resolvable aliases list returned")
+	}
+
+	values := []string{}
+	items := []interface{}{}
+	for _, a := range alr.GetItems() {
+		values = append(values, a.Value)
+		items = append(items, map[string]interface{}{
+			IDKey:                                a.Id,
+			ScopeIdKey:                           a.ScopeId,
+			ValueKey:                             a.Value,
+			DestinationIdKey:                     a.DestinationId,
+			TypeKey:                              a.Type,
+			aliasTargetAuthorizeSessionHostIdKey: targetAliasHostId(a),
+		})
+	}
+
+	if err := d.Set(resolvableAliasesValuesKey, values); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(aliasTargetSetAliasesKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userId)
+	return nil
+}
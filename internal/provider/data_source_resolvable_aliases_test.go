@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const resolvableAliasesDataSourceName = "data.boundary_resolvable_aliases.admin"
+
+var resolvableAliasesDataSource = `
+data "boundary_user" "admin" {
+	name = "admin"
+}
+
+data "boundary_resolvable_aliases" "admin" {
+	user_id    = data.boundary_user.admin.id
+	filter     = "\"/item/value\" == \"one.fleet.example\""
+	depends_on = [boundary_alias_target_set.example]
+}`
+
+func TestAccResolvableAliasesRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSet, resolvableAliasesDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resolvableAliasesDataSourceName, IDKey, "data.boundary_user.admin", IDKey),
+					resource.TestCheckResourceAttr(resolvableAliasesDataSourceName, resolvableAliasesValuesKey+".#", "1"),
+					resource.TestCheckResourceAttr(resolvableAliasesDataSourceName, resolvableAliasesValuesKey+".0", "one.fleet.example"),
+					resource.TestCheckResourceAttrPair(resolvableAliasesDataSourceName, aliasTargetSetAliasesKey+".0."+DestinationIdKey, "boundary_target.foo", IDKey),
+					resource.TestCheckResourceAttrPair(resolvableAliasesDataSourceName, aliasTargetSetAliasesKey+".0."+aliasTargetAuthorizeSessionHostIdKey, "boundary_host_static.alias_one", IDKey),
+				),
+			},
+		},
+	})
+}
@@ -70,7 +70,7 @@ func TestAccRoleReadGlobal(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, roleReadGlobal),
@@ -102,7 +102,7 @@ func TestAccRoleReadOrg(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, roleReadOrg),
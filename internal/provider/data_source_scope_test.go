@@ -66,8 +66,8 @@ func TestAccScopeRead(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckScopeResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// create and read
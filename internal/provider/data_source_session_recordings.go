@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	sessionRecordingsKey = "session_recordings"
+
+	sessionRecordingSessionIdKey   = "session_id"
+	sessionRecordingStateKey       = "state"
+	sessionRecordingDurationKey    = "duration"
+	sessionRecordingStartTimeKey   = "start_time"
+	sessionRecordingEndTimeKey     = "end_time"
+	sessionRecordingRetainUntilKey = "retain_until"
+	sessionRecordingDeleteAfterKey = "delete_after"
+)
+
+func dataSourceSessionRecordings() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_session_recordings data source allows you to list the Boundary session recordings " +
+			"of a scope, optionally only those of a target, a user or a storage bucket. Session recordings are a " +
+			"Boundary Enterprise feature.",
+		ReadContext: dataSourceSessionRecordingsRead,
+
+		Schema: map[string]*schema.Schema{
+			ScopeIdKey: {
+				Description:  "The ID of the scope to list the session recordings of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			RecursiveKey: {
+				Description: "Whether the session recordings of the child scopes are listed too. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			TargetIdKey: {
+				Description: "Only return the session recordings of sessions to this target.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			UserIdKey: {
+				Description: "Only return the session recordings of sessions held by this user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			targetStorageBucketIdKey: {
+				Description: "Only return the session recordings stored in this storage bucket.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			IdsKey: {
+				Description: "The IDs of the session recordings found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			sessionRecordingsKey: {
+				Description: "The session recordings found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the session recording.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope the session recording is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingSessionIdKey: {
+							Description: "The ID of the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TargetIdKey: {
+							Description: "The ID of the target of the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						UserIdKey: {
+							Description: "The ID of the user that held the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetStorageBucketIdKey: {
+							Description: "The ID of the storage bucket the session recording is stored in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the session recording.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingStateKey: {
+							Description: "The state of the session recording, e.g. `started`, `available` or `unknown`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingDurationKey: {
+							Description: "The duration of the recorded session, e.g. `1h2m3s`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingStartTimeKey: {
+							Description: "When the recording started, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingEndTimeKey: {
+							Description: "When the recording ended, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingRetainUntilKey: {
+							Description: "Until when the session recording must be retained according to the storage policy, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingDeleteAfterKey: {
+							Description: "After when the session recording is deleted according to the storage policy, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSessionRecordingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	recordings, err := listSessionRecordings(ctx, md, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := []string{}
+	items := []interface{}{}
+	for _, r := range recordings {
+		ids = append(ids, r.Id)
+		items = append(items, flattenSessionRecording(r))
+	}
+
+	if err := d.Set(IdsKey, ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(sessionRecordingsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get(ScopeIdKey).(string))
+	return nil
+}
+
+// listSessionRecordings lists the session recordings of the scope that match
+// the recursive, target_id, user_id and storage_bucket_id attributes of d. The
+// API does not support filters for session recordings so they are matched
+// here.
+func listSessionRecordings(ctx context.Context, md *metaData, d *schema.ResourceData) ([]*sessionrecordings.SessionRecording, error) {
+	src := sessionrecordings.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	userId := d.Get(UserIdKey).(string)
+	storageBucketId := d.Get(targetStorageBucketIdKey).(string)
+
+	srlr, err := src.List(ctx, d.Get(ScopeIdKey).(string), sessionrecordings.WithRecursive(d.Get(RecursiveKey).(bool)))
+	if err != nil {
+		return nil, fmt.Errorf("error listing session recordings: %w", err)
+	}
+	if srlr == nil {
+		return nil, fmt.Errorf("no session recordings list returned")
+	}
+
+	var recordings []*sessionrecordings.SessionRecording
+	for _, r := range srlr.GetItems() {
+		if sessionRecordingMatches(r, targetId, userId, storageBucketId) {
+			recordings = append(recordings, r)
+		}
+	}
+	return recordings, nil
+}
+
+// sessionRecordingMatches reports whether the recording is of the given
+// target, user and storage bucket, empty values match any recording
+func sessionRecordingMatches(r *sessionrecordings.SessionRecording, targetId, userId, storageBucketId string) bool {
+	switch {
+	case storageBucketId != "" && r.StorageBucketId != storageBucketId:
+		return false
+	case targetId != "" && sessionRecordingTargetId(r) != targetId:
+		return false
+	case userId != "" && sessionRecordingUserId(r) != userId:
+		return false
+	}
+	return true
+}
+
+// sessionRecordingTargetId returns the ID of the target the recorded session
+// was made to, as it was when the session started
+func sessionRecordingTargetId(r *sessionrecordings.SessionRecording) string {
+	if r.CreateTimeValues == nil || r.CreateTimeValues.Target == nil {
+		return ""
+	}
+	return r.CreateTimeValues.Target.Id
+}
+
+// sessionRecordingUserId returns the ID of the user that held the recorded
+// session
+func sessionRecordingUserId(r *sessionrecordings.SessionRecording) string {
+	if r.CreateTimeValues == nil || r.CreateTimeValues.User == nil {
+		return ""
+	}
+	return r.CreateTimeValues.User.Id
+}
+
+func flattenSessionRecording(r *sessionrecordings.SessionRecording) map[string]interface{} {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	var scopeId string
+	if r.Scope != nil {
+		scopeId = r.Scope.Id
+	}
+
+	return map[string]interface{}{
+		IDKey:                          r.Id,
+		ScopeIdKey:                     scopeId,
+		sessionRecordingSessionIdKey:   r.SessionId,
+		TargetIdKey:                    sessionRecordingTargetId(r),
+		UserIdKey:                      sessionRecordingUserId(r),
+		targetStorageBucketIdKey:       r.StorageBucketId,
+		TypeKey:                        r.Type,
+		sessionRecordingStateKey:       r.State,
+		sessionRecordingDurationKey:    r.Duration.String(),
+		sessionRecordingStartTimeKey:   formatTime(r.StartTime),
+		sessionRecordingEndTimeKey:     formatTime(r.EndTime),
+		sessionRecordingRetainUntilKey: formatTime(r.RetainUntil),
+		sessionRecordingDeleteAfterKey: formatTime(r.DeleteAfter),
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const sessionRecordingsDataSourceName = "data.boundary_session_recordings.foo"
+
+var fooSessionRecordingsDataSource = `
+data "boundary_session_recordings" "foo" {
+	depends_on = [boundary_role.proj1_admin]
+	scope_id   = "global"
+	recursive  = true
+	target_id  = "tssh_1234567890"
+}`
+
+func TestAccSessionRecordingsRead(t *testing.T) {
+	t.Skip("Skipping test until Boundary Terraform Provider can unit tests for Boundary Enterprise only features")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSessionRecordingsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sessionRecordingsDataSourceName, IDKey, "global"),
+					resource.TestCheckResourceAttr(sessionRecordingsDataSourceName, IdsKey+".#", "0"),
+					resource.TestCheckResourceAttr(sessionRecordingsDataSourceName, sessionRecordingsKey+".#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestSessionRecordingMatches(t *testing.T) {
+	t.Parallel()
+
+	r := &sessionrecordings.SessionRecording{
+		Id:              "sr_1234567890",
+		StorageBucketId: "sb_1234567890",
+		CreateTimeValues: &sessionrecordings.ValuesAtTime{
+			User:   &sessionrecordings.User{Id: "u_1234567890"},
+			Target: &sessionrecordings.Target{Id: "tssh_1234567890"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		targetId        string
+		userId          string
+		storageBucketId string
+		want            bool
+	}{
+		{name: "no filter", want: true},
+		{name: "target", targetId: "tssh_1234567890", want: true},
+		{name: "other target", targetId: "tssh_0987654321", want: false},
+		{name: "user", userId: "u_1234567890", want: true},
+		{name: "other user", userId: "u_0987654321", want: false},
+		{name: "storage bucket", storageBucketId: "sb_1234567890", want: true},
+		{name: "other storage bucket", storageBucketId: "sb_0987654321", want: false},
+		{name: "all", targetId: "tssh_1234567890", userId: "u_1234567890", storageBucketId: "sb_1234567890", want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sessionRecordingMatches(r, tt.targetId, tt.userId, tt.storageBucketId); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if sessionRecordingMatches(&sessionrecordings.SessionRecording{}, "tssh_1234567890", "", "") {
+		t.Fatal("recording without create time values should not match a target")
+	}
+}
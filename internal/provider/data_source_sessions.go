@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	sessionsStatusKey = "status"
+	sessionsKey       = "sessions"
+
+	sessionHostIdKey          = "host_id"
+	sessionExpirationTimeKey  = "expiration_time"
+	sessionConnectionCountKey = "connection_count"
+
+	sessionStatusPending    = "pending"
+	sessionStatusActive     = "active"
+	sessionStatusCanceling  = "canceling"
+	sessionStatusTerminated = "terminated"
+)
+
+func dataSourceSessions() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_sessions data source allows you to list the Boundary sessions of a scope, " +
+			"for instance to check the active sessions of a target or a user before making changes.",
+		ReadContext: dataSourceSessionsRead,
+
+		Schema: map[string]*schema.Schema{
+			ScopeIdKey: {
+				Description:  "The ID of the scope to list the sessions of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			RecursiveKey: {
+				Description: "Whether the sessions of the child scopes are listed too. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			FilterKey: {
+				Description: "A Boundary filter expression the sessions must match, " +
+					"e.g. `\"/item/target_id\" == \"ttcp_1234567890\"`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			sessionsStatusKey: {
+				Description: "Only return the sessions with this status, one of `pending`, `active`, `canceling` " +
+					"or `terminated`. Terminated sessions are only returned when this is set to `terminated`.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sessionStatusPending,
+					sessionStatusActive,
+					sessionStatusCanceling,
+					sessionStatusTerminated,
+				}, false),
+			},
+			IdsKey: {
+				Description: "The IDs of the sessions found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			sessionsKey: {
+				Description: "The sessions found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope the session is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TargetIdKey: {
+							Description: "The ID of the target the session connects to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						UserIdKey: {
+							Description: "The ID of the user holding the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionHostIdKey: {
+							Description: "The ID of the host the session connects to, if any.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionsStatusKey: {
+							Description: "The status of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionExpirationTimeKey: {
+							Description: "When the session expires, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionConnectionCountKey: {
+							Description: "The number of connections made through the session.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSessionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	sc := sessions.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	status := d.Get(sessionsStatusKey).(string)
+
+	opts := []sessions.Option{
+		sessions.WithRecursive(d.Get(RecursiveKey).(bool)),
+		sessions.WithIncludeTerminated(status == sessionStatusTerminated),
+	}
+	if filter := d.Get(FilterKey).(string); filter != "" {
+		opts = append(opts, sessions.WithFilter(filter))
+	}
+
+	slr, err := sc.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing sessions: %v", err)
+	}
+	if slr == nil {
+		return diag.Errorf("no sessions list returned")
+	}
+
+	ids := []string{}
+	items := []interface{}{}
+	for _, s := range slr.GetItems() {
+		if status != "" && s.Status != status {
+			continue
+		}
+		ids = append(ids, s.Id)
+		items = append(items, flattenSession(s))
+	}
+
+	if err := d.Set(IdsKey, ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(sessionsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(scopeId)
+	return nil
+}
+
+func flattenSession(s *sessions.Session) map[string]interface{} {
+	var expiration string
+	if !s.ExpirationTime.IsZero() {
+		expiration = s.ExpirationTime.Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		IDKey:                     s.Id,
+		ScopeIdKey:                s.ScopeId,
+		TargetIdKey:               s.TargetId,
+		UserIdKey:                 s.UserId,
+		sessionHostIdKey:          s.HostId,
+		TypeKey:                   s.Type,
+		sessionsStatusKey:         s.Status,
+		sessionExpirationTimeKey:  expiration,
+		sessionConnectionCountKey: len(s.Connections),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const sessionsDataSourceName = "data.boundary_sessions.foo"
+
+var fooSessionsDataSource = `
+data "boundary_sessions" "foo" {
+	depends_on = [boundary_role.proj1_admin]
+	scope_id   = boundary_scope.org1.id
+	recursive  = true
+	status     = "active"
+	filter     = "\"/item/target_id\" == \"ttcp_1234567890\""
+}`
+
+func TestAccSessionsRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSessionsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(sessionsDataSourceName, IDKey, "boundary_scope.org1", IDKey),
+					resource.TestCheckResourceAttr(sessionsDataSourceName, IdsKey+".#", "0"),
+					resource.TestCheckResourceAttr(sessionsDataSourceName, sessionsKey+".#", "0"),
+				),
+			},
+		},
+	})
+}
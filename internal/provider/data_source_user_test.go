@@ -47,8 +47,8 @@ func TestAccUserDataSource_basicOrgUser(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckUserResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckUserResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -73,7 +73,7 @@ func TestAccUserDataSource_globalAdminUser(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfigWithToken(url, token, fooOrg, globalUserDataSource),
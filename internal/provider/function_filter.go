@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// filterNameMatchesFunction builds a filter matching items by name
+type filterNameMatchesFunction struct{}
+
+var _ function.Function = &filterNameMatchesFunction{}
+
+func newFilterNameMatchesFunction() function.Function {
+	return &filterNameMatchesFunction{}
+}
+
+func (f *filterNameMatchesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "filter_name_matches"
+}
+
+func (f *filterNameMatchesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a filter matching items by name",
+		MarkdownDescription: "Builds a filter expression matching the items whose name matches the given regular expression, e.g. `\"/item/name\" matches \"web\"`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                NameKey,
+				MarkdownDescription: "The regular expression the name of the items must match.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *filterNameMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, FilterWithItemNameMatches(name))
+}
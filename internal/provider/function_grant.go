@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	grantIdsKey          = "ids"
+	grantTypeKey         = "type"
+	grantActionsKey      = "actions"
+	grantOutputFieldsKey = "output_fields"
+
+	// grantIdKey is the single ID field older grants use instead of ids
+	grantIdKey = "id"
+)
+
+// roleGrant holds the fields of a role grant
+type roleGrant struct {
+	Ids          []string `json:"ids,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Actions      []string `json:"actions,omitempty"`
+	OutputFields []string `json:"output_fields,omitempty"`
+}
+
+// String returns the grant in the text format, e.g.
+// "ids=*;type=target;actions=read,authorize-session"
+func (g roleGrant) String() string {
+	var segments []string
+	if len(g.Ids) > 0 {
+		segments = append(segments, grantIdsKey+"="+strings.Join(g.Ids, ","))
+	}
+	if g.Type != "" {
+		segments = append(segments, grantTypeKey+"="+g.Type)
+	}
+	if len(g.Actions) > 0 {
+		segments = append(segments, grantActionsKey+"="+strings.Join(g.Actions, ","))
+	}
+	if len(g.OutputFields) > 0 {
+		segments = append(segments, grantOutputFieldsKey+"="+strings.Join(g.OutputFields, ","))
+	}
+	return strings.Join(segments, ";")
+}
+
+// validate checks the grant can be written in the text format and grants
+// something
+func (g roleGrant) validate() error {
+	fields := map[string][]string{
+		grantIdsKey:          g.Ids,
+		grantTypeKey:         {g.Type},
+		grantActionsKey:      g.Actions,
+		grantOutputFieldsKey: g.OutputFields,
+	}
+	for _, key := range []string{grantIdsKey, grantTypeKey, grantActionsKey, grantOutputFieldsKey} {
+		for _, v := range fields[key] {
+			if strings.ContainsAny(v, ";=,") {
+				return fmt.Errorf("%s %q cannot contain ';', '=' or ','", key, v)
+			}
+			if v == "" && key != grantTypeKey {
+				return fmt.Errorf("%s cannot contain empty values", key)
+			}
+		}
+	}
+	if len(g.Actions) == 0 && len(g.OutputFields) == 0 {
+		return errors.New("a grant needs actions or output fields")
+	}
+	return nil
+}
+
+// parseRoleGrant parses a grant in the text or the JSON format
+func parseRoleGrant(grant string) (roleGrant, error) {
+	var g roleGrant
+	grant = strings.TrimSpace(grant)
+	if grant == "" {
+		return g, errors.New("grant is empty")
+	}
+
+	if strings.HasPrefix(grant, "{") {
+		var raw struct {
+			roleGrant
+			Id string `json:"id"`
+		}
+		dec := json.NewDecoder(strings.NewReader(grant))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&raw); err != nil {
+			return g, fmt.Errorf("invalid JSON grant: %w", err)
+		}
+		g = raw.roleGrant
+		if raw.Id != "" {
+			if len(g.Ids) > 0 {
+				return g, fmt.Errorf("%q and %q cannot both be set", grantIdKey, grantIdsKey)
+			}
+			g.Ids = []string{raw.Id}
+		}
+		return g, g.validate()
+	}
+
+	seen := map[string]bool{}
+	for _, segment := range strings.Split(grant, ";") {
+		key, value, ok := strings.Cut(segment, "=")
+		if !ok {
+			return g, fmt.Errorf("segment %q is not in the key=value format", segment)
+		}
+		if seen[key] {
+			return g, fmt.Errorf("%q is set more than once", key)
+		}
+		seen[key] = true
+		switch key {
+		case grantIdsKey, grantIdKey:
+			if seen[grantIdsKey] && seen[grantIdKey] {
+				return g, fmt.Errorf("%q and %q cannot both be set", grantIdKey, grantIdsKey)
+			}
+			g.Ids = strings.Split(value, ",")
+		case grantTypeKey:
+			g.Type = value
+		case grantActionsKey:
+			g.Actions = strings.Split(value, ",")
+		case grantOutputFieldsKey:
+			g.OutputFields = strings.Split(value, ",")
+		default:
+			return g, fmt.Errorf("unknown grant field %q", key)
+		}
+	}
+	return g, g.validate()
+}
+
+// grantFunction builds a role grant
+type grantFunction struct{}
+
+var _ function.Function = &grantFunction{}
+
+func newGrantFunction() function.Function {
+	return &grantFunction{}
+}
+
+func (f *grantFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "grant"
+}
+
+func (f *grantFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a role grant",
+		MarkdownDescription: "Builds a role grant in the text format, e.g. `ids=*;type=target;actions=read,authorize-session`, " +
+			"to use in the `grant_strings` of a `boundary_role`. Empty IDs, type or actions are left out of the grant.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                grantIdsKey,
+				MarkdownDescription: "The IDs the grant applies to, `*` for every resource.",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                grantTypeKey,
+				MarkdownDescription: "The resource type the grant applies to, e.g. `target` or `*`.",
+				AllowNullValue:      true,
+			},
+			function.ListParameter{
+				Name:                grantActionsKey,
+				MarkdownDescription: "The actions granted, e.g. `read` or `authorize-session`.",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                grantOutputFieldsKey,
+			MarkdownDescription: "The fields returned by the granted actions, e.g. `id` or `name`.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *grantFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var g roleGrant
+	var typ *string
+	resp.Error = req.Arguments.Get(ctx, &g.Ids, &typ, &g.Actions, &g.OutputFields)
+	if resp.Error != nil {
+		return
+	}
+	if typ != nil {
+		g.Type = *typ
+	}
+	if err := g.validate(); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, g.String())
+}
+
+// grantObjectAttributeTypes are the attributes of the object returned by
+// parse_grant
+var grantObjectAttributeTypes = map[string]attr.Type{
+	grantIdsKey:          types.ListType{ElemType: types.StringType},
+	grantTypeKey:         types.StringType,
+	grantActionsKey:      types.ListType{ElemType: types.StringType},
+	grantOutputFieldsKey: types.ListType{ElemType: types.StringType},
+}
+
+// parseGrantFunction returns the fields of a role grant
+type parseGrantFunction struct{}
+
+var _ function.Function = &parseGrantFunction{}
+
+func newParseGrantFunction() function.Function {
+	return &parseGrantFunction{}
+}
+
+func (f *parseGrantFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_grant"
+}
+
+func (f *parseGrantFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a role grant",
+		MarkdownDescription: "Parses a role grant in the text or the JSON format and returns an object with its `ids`, " +
+			"`type`, `actions` and `output_fields`. Fields missing from the grant are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "grant",
+				MarkdownDescription: "The grant to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: grantObjectAttributeTypes,
+		},
+	}
+}
+
+func (f *parseGrantFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var grant string
+	resp.Error = req.Arguments.Get(ctx, &grant)
+	if resp.Error != nil {
+		return
+	}
+	g, err := parseRoleGrant(grant)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	typ := types.StringNull()
+	if g.Type != "" {
+		typ = types.StringValue(g.Type)
+	}
+	obj, diags := types.ObjectValue(grantObjectAttributeTypes, map[string]attr.Value{
+		grantIdsKey:          stringListValue(g.Ids),
+		grantTypeKey:         typ,
+		grantActionsKey:      stringListValue(g.Actions),
+		grantOutputFieldsKey: stringListValue(g.OutputFields),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, obj)
+}
+
+// stringListValue returns a list of the values, or null if there are none
+func stringListValue(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
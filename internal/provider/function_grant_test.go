@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRunFunction calls f with args and returns its result
+func testRunFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func testStringList(values ...string) types.List {
+	return stringListValue(values)
+}
+
+func testOutputFields(values ...string) types.Tuple {
+	elemTypes := make([]attr.Type, 0, len(values))
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elemTypes = append(elemTypes, types.StringType)
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+func TestGrantFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []attr.Value
+		want    string
+		wantErr string
+	}{
+		{
+			name: "ids type and actions",
+			args: []attr.Value{testStringList("*"), types.StringValue("target"), testStringList("read", "authorize-session"), testOutputFields()},
+			want: "ids=*;type=target;actions=read,authorize-session",
+		},
+		{
+			name: "output fields",
+			args: []attr.Value{testStringList("ttcp_1234567890", "tssh_1234567890"), types.StringNull(), testStringList("read"), testOutputFields("id", "name")},
+			want: "ids=ttcp_1234567890,tssh_1234567890;actions=read;output_fields=id,name",
+		},
+		{
+			name: "only output fields",
+			args: []attr.Value{testStringList("*"), types.StringValue("*"), types.ListNull(types.StringType), testOutputFields("id")},
+			want: "ids=*;type=*;output_fields=id",
+		},
+		{
+			name:    "nothing granted",
+			args:    []attr.Value{testStringList("*"), types.StringValue("*"), types.ListNull(types.StringType), testOutputFields()},
+			wantErr: "a grant needs actions or output fields",
+		},
+		{
+			name:    "separator in a value",
+			args:    []attr.Value{testStringList("*"), types.StringValue("target;actions=*"), testStringList("read"), testOutputFields()},
+			wantErr: `type "target;actions=*" cannot contain ';', '=' or ','`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testRunFunction(t, newGrantFunction(), types.StringUnknown(), tt.args...)
+			if tt.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, types.StringValue(tt.want), got)
+		})
+	}
+}
+
+func TestParseRoleGrant(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		grant   string
+		want    roleGrant
+		wantErr string
+	}{
+		{
+			grant: "ids=*;type=target;actions=read,authorize-session",
+			want:  roleGrant{Ids: []string{"*"}, Type: "target", Actions: []string{"read", "authorize-session"}},
+		},
+		{
+			grant: "id=ttcp_1234567890;actions=read;output_fields=id,name",
+			want:  roleGrant{Ids: []string{"ttcp_1234567890"}, Actions: []string{"read"}, OutputFields: []string{"id", "name"}},
+		},
+		{
+			grant: `{"ids": ["*"], "type": "*", "actions": ["read"]}`,
+			want:  roleGrant{Ids: []string{"*"}, Type: "*", Actions: []string{"read"}},
+		},
+		{
+			grant: `{"id": "u_1234567890", "output_fields": ["id"]}`,
+			want:  roleGrant{Ids: []string{"u_1234567890"}, OutputFields: []string{"id"}},
+		},
+		{grant: "", wantErr: "grant is empty"},
+		{grant: "ids=*;type", wantErr: `segment "type" is not in the key=value format`},
+		{grant: "ids=*;actions=read;actions=list", wantErr: `"actions" is set more than once`},
+		{grant: "id=u_1234567890;ids=*;actions=read", wantErr: `"id" and "ids" cannot both be set`},
+		{grant: "ids=*;scope=global;actions=read", wantErr: `unknown grant field "scope"`},
+		{grant: "ids=*;type=target", wantErr: "a grant needs actions or output fields"},
+		{grant: `{"ids": ["*"], "action": ["read"]}`, wantErr: "invalid JSON grant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.grant, func(t *testing.T) {
+			got, err := parseRoleGrant(tt.grant)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseGrantFunction(t *testing.T) {
+	t.Parallel()
+
+	got, err := testRunFunction(t, newParseGrantFunction(), types.ObjectUnknown(grantObjectAttributeTypes),
+		types.StringValue("ids=*;actions=read,list"))
+	require.Nil(t, err)
+	want := types.ObjectValueMust(grantObjectAttributeTypes, map[string]attr.Value{
+		grantIdsKey:          testStringList("*"),
+		grantTypeKey:         types.StringNull(),
+		grantActionsKey:      testStringList("read", "list"),
+		grantOutputFieldsKey: types.ListNull(types.StringType),
+	})
+	assert.Equal(t, want, got)
+
+	_, err = testRunFunction(t, newParseGrantFunction(), types.ObjectUnknown(grantObjectAttributeTypes),
+		types.StringValue("ids=*"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "a grant needs actions or output fields")
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	globalScopeKind  = "global"
+	orgScopeKind     = "org"
+	projectScopeKind = "project"
+)
+
+// idPrefixResourceTypes are the resource types of the IDs, keyed by the
+// prefix Boundary gives them
+var idPrefixResourceTypes = map[string]string{
+	"o":        "boundary_scope",
+	"p":        "boundary_scope",
+	"u":        "boundary_user",
+	"g":        "boundary_group",
+	"r":        "boundary_role",
+	"ampw":     "boundary_auth_method_password",
+	"amoidc":   "boundary_auth_method_oidc",
+	"amldap":   "boundary_auth_method_ldap",
+	"acctpw":   "boundary_account_password",
+	"acctoidc": "boundary_account_oidc",
+	"acctldap": "boundary_account_ldap",
+	"mgoidc":   "boundary_managed_group",
+	"mgldap":   "boundary_managed_group_ldap",
+	"hcst":     "boundary_host_catalog_static",
+	"hcplg":    "boundary_host_catalog_plugin",
+	"hst":      "boundary_host_static",
+	"hsst":     "boundary_host_set_static",
+	"hsplg":    "boundary_host_set_plugin",
+	"ttcp":     "boundary_target_tcp",
+	"tssh":     "boundary_target_ssh",
+	"trdp":     "boundary_target_rdp",
+	"csvlt":    "boundary_credential_store_vault",
+	"csst":     "boundary_credential_store_static",
+	"clvlt":    "boundary_credential_library_vault",
+	"clvsclt":  "boundary_credential_library_vault_ssh_certificate",
+	"clvldap":  "boundary_credential_library_vault_ldap",
+	"credup":   "boundary_credential_username_password",
+	"credupd":  "boundary_credential_username_password_domain",
+	"credp":    "boundary_credential_password",
+	"credspk":  "boundary_credential_ssh_private_key",
+	"credjson": "boundary_credential_json",
+	"w":        "boundary_worker",
+	"sb":       "boundary_storage_bucket",
+	"pst":      "boundary_policy_storage",
+	"alt":      "boundary_alias_target",
+}
+
+// idResourceType returns the resource type of a Boundary ID
+func idResourceType(id string) (string, error) {
+	if id == globalScopeId {
+		return "boundary_scope", nil
+	}
+	prefix, _, ok := strings.Cut(id, "_")
+	if !ok {
+		return "", fmt.Errorf("%q is not a Boundary ID", id)
+	}
+	typ, ok := idPrefixResourceTypes[prefix]
+	if !ok {
+		return "", fmt.Errorf("unknown ID prefix %q", prefix+"_")
+	}
+	return typ, nil
+}
+
+// idScopeKind returns the kind of the scope of a scope ID
+func idScopeKind(id string) (string, error) {
+	switch {
+	case id == globalScopeId:
+		return globalScopeKind, nil
+	case strings.HasPrefix(id, orgScopePrefix):
+		return orgScopeKind, nil
+	case strings.HasPrefix(id, projectScopePrefix):
+		return projectScopeKind, nil
+	default:
+		return "", fmt.Errorf("%q is not a scope ID", id)
+	}
+}
+
+// idTypeFunction returns the resource type of an ID
+type idTypeFunction struct{}
+
+var _ function.Function = &idTypeFunction{}
+
+func newIdTypeFunction() function.Function {
+	return &idTypeFunction{}
+}
+
+func (f *idTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "id_type"
+}
+
+func (f *idTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the resource type of an ID",
+		MarkdownDescription: "Returns the type of the resource managing a Boundary ID, found from the prefix of the ID, " +
+			"e.g. `boundary_auth_method_password` for `ampw_1234567890` or `boundary_target_tcp` for `ttcp_1234567890`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The Boundary ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *idTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+	typ, err := idResourceType(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, typ)
+}
+
+// scopeKindFunction returns the kind of a scope
+type scopeKindFunction struct{}
+
+var _ function.Function = &scopeKindFunction{}
+
+func newScopeKindFunction() function.Function {
+	return &scopeKindFunction{}
+}
+
+func (f *scopeKindFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "scope_kind"
+}
+
+func (f *scopeKindFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the kind of a scope",
+		MarkdownDescription: "Returns `global`, `org` or `project` depending on the scope ID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "scope_id",
+				MarkdownDescription: "The scope ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *scopeKindFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+	kind, err := idScopeKind(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, kind)
+}
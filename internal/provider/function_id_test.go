@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdTypeFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"global":           "boundary_scope",
+		"o_1234567890":     "boundary_scope",
+		"p_1234567890":     "boundary_scope",
+		"u_1234567890":     "boundary_user",
+		"ampw_1234567890":  "boundary_auth_method_password",
+		"amoidc_123456789": "boundary_auth_method_oidc",
+		"acctpw_123456789": "boundary_account_password",
+		"ttcp_1234567890":  "boundary_target_tcp",
+		"tssh_1234567890":  "boundary_target_ssh",
+		"trdp_1234567890":  "boundary_target_rdp",
+		"credp_123456789":  "boundary_credential_password",
+		"credupd_1234567":  "boundary_credential_username_password_domain",
+		"clvldap_1234567":  "boundary_credential_library_vault_ldap",
+		"hsst_1234567890":  "boundary_host_set_static",
+		"csvlt_123456789":  "boundary_credential_store_vault",
+		"sb_1234567890":    "boundary_storage_bucket",
+		"pst_1234567890":   "boundary_policy_storage",
+	}
+	for id, want := range tests {
+		got, err := testRunFunction(t, newIdTypeFunction(), types.StringUnknown(), types.StringValue(id))
+		require.Nil(t, err, id)
+		assert.Equal(t, types.StringValue(want), got, id)
+	}
+
+	// Every type is a resource of the provider
+	resp, err := testMuxServer(t).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	resourceTypes := map[string]bool{}
+	for prefix, typ := range idPrefixResourceTypes {
+		assert.Contains(t, resp.ResourceSchemas, typ, prefix)
+		resourceTypes[typ] = true
+	}
+
+	// Every resource of a single Boundary resource has a type, except the
+	// generic ones replaced by typed resources and the ones attaching or
+	// managing several Boundary resources
+	untyped := map[string]bool{
+		"boundary_account":                                  true,
+		"boundary_alias_target_set":                         true,
+		"boundary_auth_method":                              true,
+		"boundary_host":                                     true,
+		"boundary_host_catalog":                             true,
+		"boundary_host_set":                                 true,
+		"boundary_host_static_set":                          true,
+		"boundary_scope_alias_suffix":                       true,
+		"boundary_scope_policy_attachment":                  true,
+		"boundary_scope_primary_auth_method":                true,
+		"boundary_session_recording_reapply_storage_policy": true,
+		"boundary_target":                                   true,
+		"boundary_target_credential_source":                 true,
+		"boundary_target_host_source":                       true,
+	}
+	for name := range resp.ResourceSchemas {
+		if untyped[name] {
+			continue
+		}
+		assert.True(t, resourceTypes[name], "no ID prefix gives %s", name)
+	}
+
+	for id, wantErr := range map[string]string{
+		"ampw1234567890": `"ampw1234567890" is not a Boundary ID`,
+		"xyz_1234567890": `unknown ID prefix "xyz_"`,
+	} {
+		_, err := testRunFunction(t, newIdTypeFunction(), types.StringUnknown(), types.StringValue(id))
+		require.NotNil(t, err, id)
+		assert.Contains(t, err.Error(), wantErr, id)
+	}
+}
+
+func TestScopeKindFunction(t *testing.T) {
+	t.Parallel()
+
+	for id, want := range map[string]string{
+		"global":       globalScopeKind,
+		"o_1234567890": orgScopeKind,
+		"p_1234567890": projectScopeKind,
+	} {
+		got, err := testRunFunction(t, newScopeKindFunction(), types.StringUnknown(), types.StringValue(id))
+		require.Nil(t, err, id)
+		assert.Equal(t, types.StringValue(want), got, id)
+	}
+
+	_, err := testRunFunction(t, newScopeKindFunction(), types.StringUnknown(), types.StringValue("u_1234567890"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `"u_1234567890" is not a scope ID`)
+}
+
+func TestFilterNameMatchesFunction(t *testing.T) {
+	t.Parallel()
+
+	got, err := testRunFunction(t, newFilterNameMatchesFunction(), types.StringUnknown(), types.StringValue("web"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue(`"/item/name" matches "web"`), got)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hostSetWaitForSyncKey         = "wait_for_sync"
+	hostSetWaitForSyncTimeoutKey  = "timeout"
+	hostSetWaitForSyncMinHostsKey = "min_hosts"
+
+	hostSetWaitForSyncDefaultTimeout  = "10m"
+	hostSetWaitForSyncDefaultMinHosts = 1
+)
+
+// hostSetSyncPollInterval is how often a plugin host set is read while
+// waiting for its hosts to be synced
+var hostSetSyncPollInterval = 5 * time.Second
+
+// hostSetSyncKeys are the attributes of a plugin host set that change which
+// hosts the plugin syncs into it, an update only waits for the sync when one
+// of them changes
+var hostSetSyncKeys = []string{
+	PreferredEndpointsKey,
+	AttributesJsonKey,
+	SyncIntervalSecondsKey,
+}
+
+func hostSetWaitForSyncSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Wait after the host set is created, or after its `preferred_endpoints`, `attributes` or " +
+			"`sync_interval_seconds` are updated, until the plugin has synced at least `min_hosts` hosts into it, so " +
+			"that resources depending on the host set do not race the sync. A timeout on create is reported as a warning.",
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				hostSetWaitForSyncTimeoutKey: {
+					Description:  "How long to wait for the hosts to be synced, e.g. `5m`. Defaults to `10m`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      hostSetWaitForSyncDefaultTimeout,
+					ValidateFunc: validateDuration,
+				},
+				hostSetWaitForSyncMinHostsKey: {
+					Description:  "The minimum number of hosts the host set must contain. Defaults to 1.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      hostSetWaitForSyncDefaultMinHosts,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// hostSetWaitForSync returns the configured timeout and minimum host count,
+// ok is false when wait_for_sync is not set
+func hostSetWaitForSync(d resourceDataGetter) (timeout time.Duration, minHosts int, ok bool, err error) {
+	raw, set := d.GetOk(hostSetWaitForSyncKey)
+	if !set {
+		return 0, 0, false, nil
+	}
+	blocks := raw.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return 0, 0, false, nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	timeoutStr, _ := block[hostSetWaitForSyncTimeoutKey].(string)
+	if timeoutStr == "" {
+		timeoutStr = hostSetWaitForSyncDefaultTimeout
+	}
+	if timeout, err = time.ParseDuration(timeoutStr); err != nil {
+		return 0, 0, false, fmt.Errorf("invalid %q: %w", hostSetWaitForSyncTimeoutKey, err)
+	}
+
+	minHosts, _ = block[hostSetWaitForSyncMinHostsKey].(int)
+	if minHosts < 1 {
+		minHosts = hostSetWaitForSyncDefaultMinHosts
+	}
+	return timeout, minHosts, true, nil
+}
+
+// waitForHostSetSync polls the host set until it contains at least the
+// configured number of hosts. It does nothing when wait_for_sync is not set
+// and returns an error once the timeout is reached.
+func waitForHostSetSync(ctx context.Context, md *metaData, d *schema.ResourceData) error {
+	timeout, minHosts, ok, err := hostSetWaitForSync(d)
+	if err != nil || !ok {
+		return err
+	}
+
+	hsClient := hostsets.NewClient(md.client)
+	hostSetId := d.Id()
+	deadline := time.Now().Add(timeout)
+	for {
+		hsrr, err := hsClient.Read(ctx, hostSetId)
+		if err != nil {
+			return fmt.Errorf("error reading host set %q while waiting for it to sync: %w", hostSetId, err)
+		}
+		if hsrr == nil || hsrr.Item == nil {
+			return fmt.Errorf("host set nil after read")
+		}
+
+		hosts := len(hsrr.Item.HostIds)
+		if hosts >= minHosts {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("timed out after %s waiting for host set %q to sync: found %d host(s), expected at least %d",
+				timeout, hostSetId, hosts, minHosts)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(hostSetSyncPollInterval, time.Until(deadline))):
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestHostSetWaitForSync(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		raw          map[string]interface{}
+		wantOk       bool
+		wantTimeout  time.Duration
+		wantMinHosts int
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "defaults",
+			raw: map[string]interface{}{
+				hostSetWaitForSyncKey: []interface{}{map[string]interface{}{}},
+			},
+			wantOk:       true,
+			wantTimeout:  10 * time.Minute,
+			wantMinHosts: 1,
+		},
+		{
+			name: "configured",
+			raw: map[string]interface{}{
+				hostSetWaitForSyncKey: []interface{}{map[string]interface{}{
+					hostSetWaitForSyncTimeoutKey:  "90s",
+					hostSetWaitForSyncMinHostsKey: 3,
+				}},
+			},
+			wantOk:       true,
+			wantTimeout:  90 * time.Second,
+			wantMinHosts: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceHostSetPlugin().Schema, tt.raw)
+
+			timeout, minHosts, ok, err := hostSetWaitForSync(d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != tt.wantOk || timeout != tt.wantTimeout || minHosts != tt.wantMinHosts {
+				t.Fatalf("got ok %t, timeout %s and min hosts %d, want %t, %s and %d",
+					ok, timeout, minHosts, tt.wantOk, tt.wantTimeout, tt.wantMinHosts)
+			}
+		})
+	}
+}
+
+// testUnsyncedHostSetMetaData fakes a controller whose plugin never syncs any
+// host into the host set
+func testUnsyncedHostSetMetaData(t *testing.T) *metaData {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"hsplg_1234567890","type":"plugin","host_catalog_id":"hcplg_1234567890","version":1}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetAddr(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	client.SetMaxRetries(0)
+	return &metaData{client: client}
+}
+
+func TestHostSetPluginWaitForSync(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	md := testUnsyncedHostSetMetaData(t)
+
+	raw := map[string]interface{}{
+		TypeKey:          hostSetTypePlugin,
+		HostCatalogIdKey: "hcplg_1234567890",
+		DescriptionKey:   "foo",
+		hostSetWaitForSyncKey: []interface{}{map[string]interface{}{
+			hostSetWaitForSyncTimeoutKey: "1ms",
+		}},
+	}
+
+	// A sync timeout on create is a warning, the host set is not tainted
+	d := schema.TestResourceDataRaw(t, resourceHostSetPlugin().Schema, raw)
+	diags := resourceHostSetPluginCreate(ctx, d, md)
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %#v", diags)
+	}
+	if d.Id() != "hsplg_1234567890" {
+		t.Fatalf("expected the host set ID to be set, got %q", d.Id())
+	}
+
+	// Updating the description does not wait for the hosts
+	d = schema.TestResourceDataRaw(t, resourceHostSetPlugin().Schema, raw)
+	d.SetId("hsplg_1234567890")
+	if diags := resourceHostSetPluginUpdate(ctx, d, md); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	// Updating the attributes waits for the hosts
+	raw[AttributesJsonKey] = `{"region":"us-east-1"}`
+	d = schema.TestResourceDataRaw(t, resourceHostSetPlugin().Schema, raw)
+	d.SetId("hsplg_1234567890")
+	if diags := resourceHostSetPluginUpdate(ctx, d, md); !diags.HasError() {
+		t.Fatal("expected the update to time out waiting for the hosts")
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	kms_plugin_assets "github.com/hashicorp/terraform-provider-boundary/plugins/kms"
+)
+
+const (
+	recoveryKmsPurpose = "recovery"
+
+	kmsPluginDirKey       = "kms_plugin_dir"
+	kmsPluginChecksumsKey = "kms_plugin_checksums"
+)
+
+var (
+	kmsPluginCleanupsLock sync.Mutex
+	// kmsPluginCleanups are the cleanup functions of the KMS plugins started
+	// by the provider, they stop the plugin processes and remove their
+	// binaries
+	kmsPluginCleanups []func() error
+)
+
+// registerKmsPluginCleanup records the cleanup function of a KMS plugin so it
+// is run by CleanupKmsPlugins
+func registerKmsPluginCleanup(cleanup func() error) {
+	if cleanup == nil {
+		return
+	}
+	kmsPluginCleanupsLock.Lock()
+	defer kmsPluginCleanupsLock.Unlock()
+	kmsPluginCleanups = append(kmsPluginCleanups, cleanup)
+}
+
+// CleanupKmsPlugins stops the KMS plugin processes started by the provider
+// and removes the binaries extracted to run them. It must be called once the
+// provider is done serving requests.
+func CleanupKmsPlugins() error {
+	kmsPluginCleanupsLock.Lock()
+	cleanups := kmsPluginCleanups
+	kmsPluginCleanups = nil
+	kmsPluginCleanupsLock.Unlock()
+
+	var errs []error
+	for _, cleanup := range cleanups {
+		if err := cleanup(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// kmsPluginConfig holds the provider settings that control where KMS plugins
+// are loaded from and executed
+type kmsPluginConfig struct {
+	// executionDir is where plugins are written to and executed from
+	executionDir string
+	// externalDir holds boundary-plugin-kms-* binaries that take precedence
+	// over the embedded plugins
+	externalDir string
+	// checksums pins the hex encoded SHA-256 of the plugins in externalDir,
+	// keyed by KMS type
+	checksums map[string]string
+}
+
+// kmsPluginConfigFromResourceData reads the KMS plugin settings of the
+// provider
+func kmsPluginConfigFromResourceData(d *schema.ResourceData) kmsPluginConfig {
+	cfg := kmsPluginConfig{
+		checksums: map[string]string{},
+	}
+	if v, ok := d.GetOk("plugin_execution_dir"); ok {
+		cfg.executionDir = v.(string)
+	}
+	if v, ok := d.GetOk(kmsPluginDirKey); ok {
+		cfg.externalDir = v.(string)
+	}
+	if v, ok := d.GetOk(kmsPluginChecksumsKey); ok {
+		for kmsType, sum := range v.(map[string]interface{}) {
+			cfg.checksums[strings.ToLower(kmsType)] = sum.(string)
+		}
+	}
+	return cfg
+}
+
+// recoveryKmsPluginOptions returns the plugin options used to create the
+// recovery KMS wrappers. Plugins found in the external plugin directory are
+// used instead of the embedded ones and must match their pinned checksum.
+// Otherwise, when an execution directory is set, the embedded plugin needed by
+// the recovery KMS blocks is extracted there once and reused by later runs.
+func recoveryKmsPluginOptions(kmses []*configutil.KMS, cfg kmsPluginConfig) ([]pluginutil.Option, error) {
+	opts := []pluginutil.Option{
+		pluginutil.WithPluginsMap(kms_plugin_assets.BuiltinKmsPlugins()),
+		pluginutil.WithPluginsFilesystem(kms_plugin_assets.KmsPluginPrefix, kms_plugin_assets.FileSystem()),
+	}
+	if cfg.executionDir != "" {
+		opts = append(opts, pluginutil.WithPluginExecutionDirectory(cfg.executionDir))
+	}
+	if cfg.executionDir == "" && cfg.externalDir == "" {
+		return opts, nil
+	}
+
+	builtin := kms_plugin_assets.BuiltinKmsPlugins()
+	for _, kms := range kmses {
+		kmsType := strings.ToLower(kms.Type)
+		if kms.PluginPath != "" {
+			continue
+		}
+		if _, ok := builtin[kmsType]; ok {
+			continue
+		}
+
+		var info *pluginutil.PluginFileInfo
+		var err error
+		if cfg.externalDir != "" {
+			if info, err = externalKmsPlugin(cfg.externalDir, kmsType, cfg.checksums[kmsType]); err != nil {
+				return nil, err
+			}
+		}
+		if info == nil && cfg.executionDir != "" {
+			if info, err = cacheKmsPlugin(kms_plugin_assets.FileSystem(), cfg.executionDir, kmsType); err != nil {
+				return nil, err
+			}
+		}
+		if info != nil {
+			// Given after the plugins filesystem, this takes precedence over
+			// the embedded plugin of the same type
+			opts = append(opts, pluginutil.WithPluginFile(*info))
+		}
+	}
+
+	return opts, nil
+}
+
+// externalKmsPlugin returns the plugin for kmsType found in dir, or nil if
+// there is none. The plugin must match the pinned checksum, which is checked
+// here to give a clear error and again by go-plugin when it is executed.
+func externalKmsPlugin(dir, kmsType, checksum string) (*pluginutil.PluginFileInfo, error) {
+	path := filepath.Join(dir, kms_plugin_assets.KmsPluginPrefix+kmsType)
+	if runtime.GOOS == "windows" {
+		path += ".exe"
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading kms plugin %q: %w", path, err)
+	}
+
+	if checksum == "" {
+		return nil, fmt.Errorf("kms plugin %q has no checksum pinned in %q", path, kmsPluginChecksumsKey)
+	}
+	want, err := hex.DecodeString(checksum)
+	if err != nil || len(want) != sha256.Size {
+		return nil, fmt.Errorf("checksum pinned for kms plugin %q is not a hex encoded SHA-256", kmsType)
+	}
+	got, err := fileSha256(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading kms plugin %q: %w", path, err)
+	}
+	if !bytes.Equal(got, want) {
+		return nil, fmt.Errorf("kms plugin %q has SHA-256 %x but %s is pinned", path, got, checksum)
+	}
+
+	return kmsPluginFileInfo(kmsType, path, want), nil
+}
+
+// cacheKmsPlugin extracts the embedded plugin for kmsType to dir, unless a
+// previous run already did. The extracted file is named after the checksum
+// of the embedded asset so a provider upgrade extracts its own plugins. A nil
+// info is returned when no plugin is embedded for kmsType.
+func cacheKmsPlugin(fsys fs.FS, dir, kmsType string) (*pluginutil.PluginFileInfo, error) {
+	assetName := kms_plugin_assets.KmsPluginPrefix + kmsType
+	if runtime.GOOS == "windows" {
+		assetName += ".exe"
+	}
+	compressed := true
+	asset, err := fs.ReadFile(fsys, assetName+".gz")
+	if errors.Is(err, fs.ErrNotExist) {
+		compressed = false
+		asset, err = fs.ReadFile(fsys, assetName)
+	}
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("error reading kms plugin %q: %w", kmsType, err)
+	}
+
+	assetSum := sha256.Sum256(asset)
+	path := filepath.Join(dir, fmt.Sprintf("%s%s-%s", kms_plugin_assets.KmsPluginPrefix, kmsType, hex.EncodeToString(assetSum[:8])))
+	sumPath := path + ".sha256"
+
+	// The checksum of the extracted binary is kept next to it so the cached
+	// copy can be verified without decompressing the asset again
+	if sum, err := cachedKmsPluginChecksum(path, sumPath); err == nil {
+		return kmsPluginFileInfo(kmsType, path, sum), nil
+	}
+
+	binary := asset
+	if compressed {
+		zr, err := gzip.NewReader(bytes.NewReader(asset))
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing kms plugin %q: %w", kmsType, err)
+		}
+		binary, err = io.ReadAll(zr)
+		zr.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing kms plugin %q: %w", kmsType, err)
+		}
+	}
+	sum := sha256.Sum256(binary)
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating plugin execution directory: %w", err)
+	}
+	if err := writeFileAtomic(path, binary, 0o700); err != nil {
+		return nil, fmt.Errorf("error writing kms plugin %q: %w", kmsType, err)
+	}
+	if err := writeFileAtomic(sumPath, []byte(hex.EncodeToString(sum[:])), 0o600); err != nil {
+		return nil, fmt.Errorf("error writing kms plugin %q checksum: %w", kmsType, err)
+	}
+
+	return kmsPluginFileInfo(kmsType, path, sum[:]), nil
+}
+
+// cachedKmsPluginChecksum returns the checksum of a previously extracted
+// plugin, or an error if it is missing or does not match its checksum file
+func cachedKmsPluginChecksum(path, sumPath string) ([]byte, error) {
+	rawSum, err := os.ReadFile(sumPath)
+	if err != nil {
+		return nil, err
+	}
+	want, err := hex.DecodeString(strings.TrimSpace(string(rawSum)))
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := fileSha256(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(got, want) {
+		return nil, fmt.Errorf("checksum mismatch for %q", path)
+	}
+	return want, nil
+}
+
+// fileSha256 returns the SHA-256 of the file at path
+func fileSha256(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+func kmsPluginFileInfo(kmsType, path string, sum []byte) *pluginutil.PluginFileInfo {
+	return &pluginutil.PluginFileInfo{
+		Name:       kmsType,
+		Path:       path,
+		Checksum:   sum,
+		HashMethod: pluginutil.HashMethodSha2256,
+	}
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and
+// renames it to path, so concurrent runs never execute a partial file
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// validateKmsPluginChecksums checks every pinned checksum is a hex encoded
+// SHA-256
+func validateKmsPluginChecksums(i interface{}, k string) ([]string, []error) {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be map", k)}
+	}
+	var errs []error
+	for kmsType, v := range m {
+		sum, _ := v.(string)
+		if b, err := hex.DecodeString(sum); err != nil || len(b) != sha256.Size {
+			errs = append(errs, fmt.Errorf("%s: checksum of %q must be a hex encoded SHA-256", k, kmsType))
+		}
+	}
+	return nil, errs
+}
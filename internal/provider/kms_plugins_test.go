@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	kms_plugin_assets "github.com/hashicorp/terraform-provider-boundary/plugins/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKmsPlugin(t *testing.T) {
+	t.Parallel()
+
+	binary := []byte("#!/bin/sh\necho plugin\n")
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, err := zw.Write(binary)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	assetName := kms_plugin_assets.KmsPluginPrefix + "test"
+	if runtime.GOOS == "windows" {
+		assetName += ".exe"
+	}
+	fsys := fstest.MapFS{
+		assetName + ".gz": &fstest.MapFile{Data: compressed.Bytes()},
+	}
+	wantSum := sha256.Sum256(binary)
+	dir := t.TempDir()
+
+	info, err := cacheKmsPlugin(fsys, dir, "test")
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "test", info.Name)
+	assert.Equal(t, pluginutil.HashMethodSha2256, info.HashMethod)
+	assert.Equal(t, wantSum[:], info.Checksum)
+	got, err := os.ReadFile(info.Path)
+	require.NoError(t, err)
+	assert.Equal(t, binary, got)
+
+	// A second run reuses the extracted plugin
+	stat, err := os.Stat(info.Path)
+	require.NoError(t, err)
+	cached, err := cacheKmsPlugin(fsys, dir, "test")
+	require.NoError(t, err)
+	assert.Equal(t, info, cached)
+	cachedStat, err := os.Stat(cached.Path)
+	require.NoError(t, err)
+	assert.True(t, os.SameFile(stat, cachedStat))
+
+	// A modified copy is replaced
+	require.NoError(t, os.WriteFile(info.Path, []byte("tampered"), 0o700))
+	replaced, err := cacheKmsPlugin(fsys, dir, "test")
+	require.NoError(t, err)
+	assert.Equal(t, info, replaced)
+	got, err = os.ReadFile(replaced.Path)
+	require.NoError(t, err)
+	assert.Equal(t, binary, got)
+
+	// Types without an embedded plugin are left to pluginutil
+	missing, err := cacheKmsPlugin(fsys, dir, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestCleanupKmsPlugins(t *testing.T) {
+	var calls int
+	registerKmsPluginCleanup(func() error {
+		calls++
+		return nil
+	})
+	registerKmsPluginCleanup(func() error {
+		calls++
+		return errors.New("boom")
+	})
+	registerKmsPluginCleanup(nil)
+
+	err := CleanupKmsPlugins()
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, 2, calls)
+
+	// Cleanups only run once
+	require.NoError(t, CleanupKmsPlugins())
+	assert.Equal(t, 2, calls)
+}
+
+func TestExternalKmsPlugin(t *testing.T) {
+	t.Parallel()
+
+	binary := []byte("#!/bin/sh\necho patched plugin\n")
+	sum := sha256.Sum256(binary)
+	checksum := hex.EncodeToString(sum[:])
+
+	dir := t.TempDir()
+	name := kms_plugin_assets.KmsPluginPrefix + "transit"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), binary, 0o700))
+
+	info, err := externalKmsPlugin(dir, "transit", checksum)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "transit", info.Name)
+	assert.Equal(t, filepath.Join(dir, name), info.Path)
+	assert.Equal(t, sum[:], info.Checksum)
+
+	_, err = externalKmsPlugin(dir, "transit", "")
+	assert.ErrorContains(t, err, "no checksum pinned")
+
+	otherSum := sha256.Sum256([]byte("other"))
+	_, err = externalKmsPlugin(dir, "transit", hex.EncodeToString(otherSum[:]))
+	assert.ErrorContains(t, err, "is pinned")
+
+	// Plugins missing from the directory fall back to the embedded ones
+	info, err = externalKmsPlugin(dir, "awskms", "")
+	require.NoError(t, err)
+	assert.Nil(t, info)
+}
+
+func TestValidateKmsPluginChecksums(t *testing.T) {
+	t.Parallel()
+
+	sum := sha256.Sum256([]byte("plugin"))
+	_, errs := validateKmsPluginChecksums(map[string]interface{}{"transit": hex.EncodeToString(sum[:])}, kmsPluginChecksumsKey)
+	assert.Empty(t, errs)
+
+	_, errs = validateKmsPluginChecksums(map[string]interface{}{"transit": "abc", "awskms": "not hex"}, kmsPluginChecksumsKey)
+	assert.Len(t, errs, 2)
+}
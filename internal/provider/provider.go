@@ -7,18 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
-	"github.com/hashicorp/boundary/sdk/wrapper"
+	"github.com/hashicorp/boundary/api/authtokens"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
-	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
-	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	goversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	kms_plugin_assets "github.com/hashicorp/terraform-provider-boundary/plugins/kms"
 )
 
 const (
@@ -48,7 +48,7 @@ func New() *schema.Provider {
 			"recovery_kms_hcl": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Can be a heredoc string or a path on disk. If set, the string/file will be parsed as HCL and used with the recovery KMS mechanism. While this is set, it will override any other authentication information; the KMS mechanism will always be used. See Boundary's KMS docs for examples: https://boundaryproject.io/docs/configuration/kms",
+				Description: "Can be a heredoc string or a path on disk. If set, the string/file will be parsed as HCL and used with the recovery KMS mechanism. Several kms blocks with the recovery purpose can be given while the recovery key is rotated; they are tried in order and the first one accepted by the controller is used. While this is set, it will override any other authentication information; the KMS mechanism will always be used. See Boundary's KMS docs for examples: https://boundaryproject.io/docs/configuration/kms",
 			},
 			"auth_method_id": {
 				Type:        schema.TypeString,
@@ -85,13 +85,36 @@ func New() *schema.Provider {
 			"plugin_execution_dir": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: `Specifies a directory that the Boundary provider can use to write and execute its built-in plugins.`,
+				Description: `Specifies a directory that the Boundary provider can use to write and execute its built-in plugins. Plugins extracted there are reused by later runs of the same provider version.`,
+			},
+			kmsPluginDirKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "A directory containing `boundary-plugin-kms-<type>` binaries to use for the recovery KMS " +
+					"instead of the plugins built into the provider, e.g. to run a patched or newer plugin. " +
+					"Every plugin used from this directory must have its checksum pinned in `kms_plugin_checksums`.",
+			},
+			kmsPluginChecksumsKey: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "The hex encoded SHA-256 checksums of the plugins in `kms_plugin_dir`, keyed by KMS type, " +
+					"e.g. `transit`. A plugin whose checksum does not match is refused.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateKmsPluginChecksums,
 			},
 			"scope_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: `The scope ID for the default auth method.`,
 			},
+			controllerVersionKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The version of the Boundary controllers, e.g. `0.16.0`. Resources and attributes that need a " +
+					"newer version are reported during plan. If not set, the version is detected from the release version " +
+					"of the workers, which are never newer than the controllers, and a newer version is only a warning.",
+				ValidateFunc: validateVersion,
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"boundary_account":                                  resourceAccount(),
@@ -99,6 +122,7 @@ func New() *schema.Provider {
 			"boundary_account_oidc":                             resourceAccountOidc(),
 			"boundary_account_ldap":                             resourceAccountLdap(),
 			"boundary_alias_target":                             resourceAliasTarget(),
+			"boundary_alias_target_set":                         resourceAliasTargetSet(),
 			"boundary_auth_method":                              resourceAuthMethod(),
 			"boundary_auth_method_password":                     resourceAuthMethodPassword(),
 			"boundary_auth_method_oidc":                         resourceAuthMethodOidc(),
@@ -124,26 +148,39 @@ func New() *schema.Provider {
 			"boundary_host_set":                                 resourceHostSet(),
 			"boundary_host_set_static":                          resourceHostSetStatic(),
 			"boundary_host_set_plugin":                          resourceHostSetPlugin(),
+			"boundary_host_static_set":                          resourceHostStaticSet(),
 			"boundary_policy_storage":                           resourcePolicyStorage(),
 			"boundary_scope_alias_suffix":                       resourceScopeAliasSuffix(),
 			"boundary_scope_policy_attachment":                  resourceScopePolicyAttachment(),
-			"boundary_role":                                     resourceRole(),
-			"boundary_scope":                                    resourceScope(),
+			"boundary_scope_primary_auth_method":                resourceScopePrimaryAuthMethod(),
+			"boundary_session_recording_reapply_storage_policy": resourceSessionRecordingReapplyStoragePolicy(),
 			"boundary_storage_bucket":                           resourceStorageBucket(),
-			"boundary_target":                                   resourceTarget(),
-			"boundary_user":                                     resourceUser(),
+			"boundary_target_credential_source":                 resourceTargetCredentialSource(),
+			"boundary_target_host_source":                       resourceTargetHostSource(),
 			"boundary_worker":                                   resourceWorker(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"boundary_account":     dataSourceAccount(),
-			"boundary_auth_method": dataSourceAuthMethod(),
-			"boundary_group":       dataSourceGroup(),
-			"boundary_scope":       dataSourceScope(),
-			"boundary_user":        dataSourceUser(),
-			"boundary_role":        dataSourceRole(),
+			"boundary_account":            dataSourceAccount(),
+			"boundary_auth_method":        dataSourceAuthMethod(),
+			"boundary_group":              dataSourceGroup(),
+			"boundary_hosts":              dataSourceHosts(),
+			"boundary_scope":              dataSourceScope(),
+			"boundary_user":               dataSourceUser(),
+			"boundary_role":               dataSourceRole(),
+			"boundary_resolvable_aliases": dataSourceResolvableAliases(),
+
+			"boundary_sessions":           dataSourceSessions(),
+			"boundary_session_recordings": dataSourceSessionRecordings(),
+
+			"boundary_credential":         dataSourceCredential(),
+			"boundary_credential_library": dataSourceCredentialLibrary(),
+			"boundary_credential_store":   dataSourceCredentialStore(),
 		},
 	}
 
+	addVersionRequirements(p)
+	addResourceTimeouts(p)
+	addResourceIdentities(p)
 	p.ConfigureContextFunc = providerConfigure(p)
 
 	return p
@@ -152,6 +189,49 @@ func New() *schema.Provider {
 type metaData struct {
 	client             *api.Client
 	recoveryKmsWrapper wrapping.Wrapper
+
+	// recoveryKmsKeyId is the key ID of the recovery KMS in use and
+	// recoveryKmsRejectedKeyIds the ones the controller rejected before it
+	recoveryKmsKeyId          string
+	recoveryKmsRejectedKeyIds []string
+
+	// controllerVersion is the version of the Boundary controllers, nil when
+	// unknown. controllerVersionDetected is set when it was derived from the
+	// workers instead of being configured.
+	controllerVersion         *goversion.Version
+	controllerVersionDetected bool
+
+	// authMethodId is the auth method the provider logged in with, it is
+	// empty when a token or the recovery KMS is used instead.
+	// authMethodIdOnce guards looking it up from the token, as resources are
+	// handled in parallel.
+	authMethodId     string
+	authMethodIdOnce sync.Once
+}
+
+// providerAuthMethodId returns the ID of the auth method the provider is
+// authenticated with. When a token was given instead of login credentials
+// the auth method is looked up from the token itself; an empty string is
+// returned if it cannot be determined, e.g. when using the recovery KMS.
+func providerAuthMethodId(ctx context.Context, md *metaData) string {
+	md.authMethodIdOnce.Do(func() {
+		if md.authMethodId != "" {
+			return
+		}
+
+		// Boundary tokens are formatted as <token ID>_<secret>, where the
+		// token ID itself is at_<random>
+		parts := strings.SplitN(md.client.Token(), "_", 3)
+		if len(parts) != 3 || parts[0] != "at" {
+			return
+		}
+		atrr, err := authtokens.NewClient(md.client).Read(ctx, parts[0]+"_"+parts[1])
+		if err != nil || atrr == nil || atrr.Item == nil {
+			return
+		}
+		md.authMethodId = atrr.Item.AuthMethodId
+	})
+	return md.authMethodId
 }
 
 func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaData) error {
@@ -191,31 +271,42 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 			return fmt.Errorf(`error reading data from "recovery_kms_hcl": %v`, err)
 		}
 
-		opts := []pluginutil.Option{
-			pluginutil.WithPluginsMap(kms_plugin_assets.BuiltinKmsPlugins()),
-			pluginutil.WithPluginsFilesystem(kms_plugin_assets.KmsPluginPrefix, kms_plugin_assets.FileSystem()),
+		kmses, err := parseRecoveryKmses(recoveryHclStr)
+		if err != nil {
+			return fmt.Errorf(`error reading wrappers from "recovery_kms_hcl": %v`, err)
 		}
-
-		if execDir, ok := d.GetOk("plugin_execution_dir"); ok {
-			opts = append(opts, pluginutil.WithPluginExecutionDirectory(execDir.(string)))
+		if len(kmses) == 0 {
+			return errors.New(`No "kms" block with purpose "recovery" found in "recovery_kms_hcl"`)
 		}
-
-		// TODO: cleanup plugin when finished
-		wrapper, _, err := wrapper.GetWrapperFromHcl(
-			ctx,
-			recoveryHclStr,
-			"recovery",
-			configutil.WithPluginOptions(opts...),
-		)
+		opts, err := recoveryKmsPluginOptions(kmses, kmsPluginConfigFromResourceData(d))
+		if err != nil {
+			return fmt.Errorf(`error preparing plugins for "recovery_kms_hcl": %v`, err)
+		}
+		configured, err := configureRecoveryKmses(ctx, recoveryHclStr, kmses, opts)
 		if err != nil {
 			return fmt.Errorf(`error reading wrappers from "recovery_kms_hcl": %v`, err)
 		}
-		if wrapper == nil {
-			return errors.New(`No "kms" block with purpose "recovery" found in "recovery_kms_hcl"`)
+
+		// Several recovery keys can be given while the recovery key is
+		// rotated, the first one the controller accepts is used and the
+		// plugins of the others are stopped right away
+		recovery, rejected, err := selectRecoveryKms(ctx, md.client, configured)
+		if err != nil {
+			cleanupRecoveryKmses(configured)
+			return err
 		}
+		for _, kms := range configured {
+			if kms != recovery {
+				cleanupRecoveryKmses([]*recoveryKms{kms})
+			}
+		}
+		registerKmsPluginCleanup(recovery.cleanup)
+		log.Printf("[INFO] using recovery KMS key %q", recovery.keyId)
 
-		md.recoveryKmsWrapper = wrapper
-		md.client.SetRecoveryKmsWrapper(wrapper)
+		md.recoveryKmsWrapper = recovery.wrapper
+		md.recoveryKmsKeyId = recovery.keyId
+		md.recoveryKmsRejectedKeyIds = rejected
+		md.client.SetRecoveryKmsWrapper(recovery.wrapper)
 		return nil
 
 	case md.client.Token() != "":
@@ -264,6 +355,7 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 			return err
 		}
 		md.client.SetToken(at.Attributes["token"].(string))
+		md.authMethodId = authMethodId.(string)
 
 	default:
 		return errors.New("no suitable auth method information found")
@@ -274,7 +366,11 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 
 func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		client, err := api.NewClient(nil)
+		config, err := api.DefaultConfig()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		client, err := api.NewClient(config)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
@@ -294,6 +390,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			}
 		}
 
+		// The client expects its own transport while TLS is configured and
+		// when connecting to a unix socket, so API requests are only logged
+		// once it is set up
+		if !strings.HasPrefix(client.Addr(), "unix://") {
+			config.HttpClient.Transport = newApiLoggingTransport(config.HttpClient.Transport)
+		}
+
 		client.SetLimiter(5, 5)
 
 		md := &metaData{
@@ -304,7 +407,23 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, diag.FromErr(err)
 		}
 
-		return md, nil
+		md.controllerVersion, md.controllerVersionDetected, err = controllerVersion(ctx, d, md)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		var diags diag.Diagnostics
+		if len(md.recoveryKmsRejectedKeyIds) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Recovery KMS keys rejected",
+				Detail: fmt.Sprintf("The controller rejected the recovery KMS keys %q and accepted %q. "+
+					"Remove the rejected keys from \"recovery_kms_hcl\" once the rotation is complete.",
+					md.recoveryKmsRejectedKeyIds, md.recoveryKmsKeyId),
+			})
+		}
+
+		return md, diags
 	}
 }
 
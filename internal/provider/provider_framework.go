@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkResources are the resources written with the plugin framework.
+// Resources are moved here from the SDK ResourcesMap one at a time, each
+// keeping the schema and state of its SDK version so existing states are used
+// as they are.
+var frameworkResources = []func() resource.Resource{
+	newRoleResource,
+	newScopeResource,
+	newTargetRdpResource,
+	newTargetResource,
+	newTargetSshResource,
+	newTargetTcpResource,
+	newUserResource,
+}
+
+// frameworkFunctions are the provider-defined functions
+var frameworkFunctions = []func() function.Function{
+	newFilterNameMatchesFunction,
+	newGrantFunction,
+	newIdTypeFunction,
+	newParseGrantFunction,
+	newScopeKindFunction,
+}
+
+// NewMuxServer returns the server of the provider, it serves the resources
+// of the SDK provider next to the ones written with the plugin framework
+func NewMuxServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	return newMuxServer(ctx, New())
+}
+
+func newMuxServer(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	servers := []func() tfprotov5.ProviderServer{
+		// The SDK provider must come first, mux configures the providers in
+		// order and the framework provider reuses the SDK provider metadata
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(newFrameworkProvider(sdkProvider)),
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider serves the resources written with the plugin framework.
+// It shares the configuration and the client of the SDK provider.
+type frameworkProvider struct {
+	sdk *schema.Provider
+}
+
+var (
+	_ fwprovider.Provider              = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions = &frameworkProvider{}
+)
+
+func newFrameworkProvider(sdk *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{sdk: sdk}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "boundary"
+}
+
+// Schema returns the schema of the SDK provider, mux requires every server to
+// have the same provider schema
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	attributes := make(map[string]fwschema.Attribute, len(p.sdk.Schema))
+	for name, s := range p.sdk.Schema {
+		a, err := frameworkProviderAttribute(s)
+		if err != nil {
+			resp.Diagnostics.AddError("Unsupported provider attribute", fmt.Sprintf("%q: %s", name, err))
+			continue
+		}
+		attributes[name] = a
+	}
+	resp.Schema = fwschema.Schema{Attributes: attributes}
+}
+
+// Configure gives the resources the metadata built when the SDK provider was
+// configured
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	md, ok := p.sdk.Meta().(*metaData)
+	if !ok || md == nil {
+		// The SDK provider reported why it could not be configured
+		return
+	}
+	resp.ResourceData = md
+	resp.DataSourceData = md
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return frameworkResources
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return frameworkFunctions
+}
+
+// frameworkProviderAttribute converts an attribute of the SDK provider schema
+func frameworkProviderAttribute(s *schema.Schema) (fwschema.Attribute, error) {
+	switch s.Type {
+	case schema.TypeString:
+		return fwschema.StringAttribute{
+			MarkdownDescription: s.Description,
+			DeprecationMessage:  s.Deprecated,
+			Required:            s.Required,
+			Optional:            s.Optional,
+			Sensitive:           s.Sensitive,
+		}, nil
+	case schema.TypeBool:
+		return fwschema.BoolAttribute{
+			MarkdownDescription: s.Description,
+			DeprecationMessage:  s.Deprecated,
+			Required:            s.Required,
+			Optional:            s.Optional,
+			Sensitive:           s.Sensitive,
+		}, nil
+	case schema.TypeInt, schema.TypeFloat:
+		return fwschema.NumberAttribute{
+			MarkdownDescription: s.Description,
+			DeprecationMessage:  s.Deprecated,
+			Required:            s.Required,
+			Optional:            s.Optional,
+			Sensitive:           s.Sensitive,
+		}, nil
+	case schema.TypeMap:
+		elem, err := frameworkElementType(s.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return fwschema.MapAttribute{
+			ElementType:         elem,
+			MarkdownDescription: s.Description,
+			DeprecationMessage:  s.Deprecated,
+			Required:            s.Required,
+			Optional:            s.Optional,
+			Sensitive:           s.Sensitive,
+		}, nil
+	case schema.TypeList:
+		elem, err := frameworkElementType(s.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return fwschema.ListAttribute{
+			ElementType:         elem,
+			MarkdownDescription: s.Description,
+			DeprecationMessage:  s.Deprecated,
+			Required:            s.Required,
+			Optional:            s.Optional,
+			Sensitive:           s.Sensitive,
+		}, nil
+	case schema.TypeSet:
+		elem, err := frameworkElementType(s.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return fwschema.SetAttribute{
+			ElementType:         elem,
+			MarkdownDescription: s.Description,
+			DeprecationMessage:  s.Deprecated,
+			Required:            s.Required,
+			Optional:            s.Optional,
+			Sensitive:           s.Sensitive,
+		}, nil
+	default:
+		return nil, fmt.Errorf("type %s is not supported", s.Type)
+	}
+}
+
+// frameworkElementType converts the element type of an SDK map, list or set,
+// which defaults to a string for maps
+func frameworkElementType(elem interface{}) (attr.Type, error) {
+	s, ok := elem.(*schema.Schema)
+	if elem == nil {
+		return types.StringType, nil
+	}
+	if !ok {
+		return nil, fmt.Errorf("only primitive elements are supported")
+	}
+	switch s.Type {
+	case schema.TypeString:
+		return types.StringType, nil
+	case schema.TypeBool:
+		return types.BoolType, nil
+	case schema.TypeInt, schema.TypeFloat:
+		return types.NumberType, nil
+	default:
+		return nil, fmt.Errorf("element type %s is not supported", s.Type)
+	}
+}
+
+// sdkStateUpgraders returns the upgrader of the states written by the SDK
+// version of r, which are at schema version 0. The SDK saved the zero value of
+// the optional attributes that are not set, they are made null as the
+// framework plans null for them.
+func sdkStateUpgraders(ctx context.Context, r resource.Resource) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	priorSchema := schemaResp.Schema
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				raw, err := tftypes.Transform(req.State.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+					if len(p.Steps()) != 1 {
+						return v, nil
+					}
+					name, ok := p.Steps()[0].(tftypes.AttributeName)
+					if !ok {
+						return v, nil
+					}
+					a, ok := priorSchema.Attributes[string(name)]
+					if !ok || !a.IsOptional() || a.IsComputed() || !isZeroValue(v) {
+						return v, nil
+					}
+					return tftypes.NewValue(v.Type(), nil), nil
+				})
+				if err != nil {
+					resp.Diagnostics.AddError("Error upgrading the state", err.Error())
+					return
+				}
+				resp.State.Raw = raw
+			},
+		},
+	}
+}
+
+// isZeroValue returns whether v is an empty string, zero, false or an empty
+// collection
+func isZeroValue(v tftypes.Value) bool {
+	if !v.IsKnown() || v.IsNull() {
+		return false
+	}
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		return v.As(&s) == nil && s == ""
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		return v.As(&n) == nil && n.Sign() == 0
+	case typ.Is(tftypes.Bool):
+		var b bool
+		return v.As(&b) == nil && !b
+	case typ.Is(tftypes.Set{}), typ.Is(tftypes.List{}):
+		var elems []tftypes.Value
+		return v.As(&elems) == nil && len(elems) == 0
+	case typ.Is(tftypes.Map{}):
+		var elems map[string]tftypes.Value
+		return v.As(&elems) == nil && len(elems) == 0
+	default:
+		return false
+	}
+}
+
+// stringFromResponseMap returns the string value of key, an absent key gives
+// a null value unless prior is an empty string. A prior zero value comes from
+// the configuration, the ones saved by the SDK are made null by
+// sdkStateUpgraders.
+func stringFromResponseMap(raw map[string]interface{}, key string, prior types.String) types.String {
+	if v, ok := raw[key].(string); ok && v != "" {
+		return types.StringValue(v)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return types.StringNull()
+}
+
+// int64FromResponseMap returns the integer value of key, an absent key gives
+// a null value unless prior is zero
+func int64FromResponseMap(raw map[string]interface{}, key string, prior types.Int64) types.Int64 {
+	if v, ok := raw[key].(json.Number); ok {
+		if i, err := v.Int64(); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueInt64() == 0 {
+		return prior
+	}
+	return types.Int64Null()
+}
+
+// boolFromResponseMap returns the boolean value of key. False values are not
+// returned by the controller, an absent key gives a null value unless prior
+// is false.
+func boolFromResponseMap(raw map[string]interface{}, key string, prior types.Bool) types.Bool {
+	if v, ok := raw[key].(bool); ok {
+		return types.BoolValue(v)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && !prior.ValueBool() {
+		return prior
+	}
+	return types.BoolNull()
+}
+
+// stringSetFromResponseMap returns the set of strings of key. Empty lists are
+// not returned by the controller, an absent key gives a null value unless
+// prior is an empty set.
+func stringSetFromResponseMap(ctx context.Context, raw map[string]interface{}, key string, prior types.Set) (types.Set, diag.Diagnostics) {
+	values := []string{}
+	if v, ok := raw[key].([]interface{}); ok {
+		for _, s := range v {
+			values = append(values, s.(string))
+		}
+	}
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown() || len(prior.Elements()) > 0) {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// stringsFromSet returns the strings of a set, none are returned when it is
+// null or unknown
+func stringsFromSet(ctx context.Context, s types.Set) ([]string, diag.Diagnostics) {
+	var values []string
+	if s.IsNull() || s.IsUnknown() {
+		return values, nil
+	}
+	diags := s.ElementsAs(ctx, &values, false)
+	return values, diags
+}
@@ -0,0 +1,483 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMuxServer(t *testing.T) tfprotov5.ProviderServer {
+	t.Helper()
+	muxServer, err := NewMuxServer(context.Background())
+	require.NoError(t, err)
+	return muxServer()
+}
+
+func TestMuxServerSchema(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resp, err := testMuxServer(t).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	// mux reports an error when the provider schemas of its servers differ
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	sdkProvider := New()
+	assert.Len(t, resp.ResourceSchemas, len(sdkProvider.ResourcesMap)+len(frameworkResources))
+	for name := range sdkProvider.ResourcesMap {
+		assert.Contains(t, resp.ResourceSchemas, name)
+	}
+	for _, name := range []string{
+		"boundary_role",
+		"boundary_scope",
+		"boundary_target",
+		"boundary_target_rdp",
+		"boundary_target_ssh",
+		"boundary_target_tcp",
+		"boundary_user",
+	} {
+		assert.Contains(t, resp.ResourceSchemas, name)
+		assert.NotContains(t, sdkProvider.ResourcesMap, name)
+	}
+
+	assert.Len(t, resp.Functions, len(frameworkFunctions))
+	for _, name := range []string{"filter_name_matches", "grant", "id_type", "parse_grant", "scope_kind"} {
+		assert.Contains(t, resp.Functions, name)
+	}
+}
+
+// testResourceState returns a state of r with the given attribute values, its
+// other attributes are null
+func testResourceState(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+	typ := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attributeType := range typ.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, v := range values {
+		require.Contains(t, attributes, name)
+		attributes[name] = v
+	}
+	return tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(typ, attributes)}
+}
+
+// testResourceIdentity returns an empty identity of r
+func testResourceIdentity(t *testing.T, r resource.ResourceWithIdentity) *tfsdk.ResourceIdentity {
+	t.Helper()
+	ctx := context.Background()
+
+	var resp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+	return &tfsdk.ResourceIdentity{
+		Schema: resp.IdentitySchema,
+		Raw:    tftypes.NewValue(resp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// testStringSetValue returns a set of strings
+func testStringSetValue(values ...string) tftypes.Value {
+	elems := make([]tftypes.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, tftypes.NewValue(tftypes.String, v))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+}
+
+// testUpgradeResourceState upgrades rawState, a state written by the SDK
+// version of typeName, with the framework version and returns its attributes
+func testUpgradeResourceState(t *testing.T, typeName, rawState string) map[string]tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	server := testMuxServer(t)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	resourceSchema := schemaResp.ResourceSchemas[typeName]
+	require.NotNil(t, resourceSchema)
+	assert.Equal(t, int64(1), resourceSchema.Version)
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: []byte(rawState)},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	state, err := resp.UpgradedState.Unmarshal(resourceSchema.ValueType())
+	require.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	require.NoError(t, state.As(&attributes))
+	return attributes
+}
+
+// testAssertStringAttributes checks the string attributes have the values of
+// want
+func testAssertStringAttributes(t *testing.T, attributes map[string]tftypes.Value, want map[string]string) {
+	t.Helper()
+	for key, v := range want {
+		var got string
+		require.NoError(t, attributes[key].As(&got), key)
+		assert.Equal(t, v, got, key)
+	}
+}
+
+// TestUserResourceStateCompatibility checks a state written by the SDK
+// version of boundary_user is read by the framework version
+func TestUserResourceStateCompatibility(t *testing.T) {
+	t.Parallel()
+
+	attributes := testUpgradeResourceState(t, "boundary_user", `{
+		"account_ids": ["acctpw_1234567890"],
+		"description": "bar",
+		"id": "u_1234567890",
+		"name": "test",
+		"scope_id": "o_1234567890",
+		"timeouts": null
+	}`)
+	testAssertStringAttributes(t, attributes, map[string]string{
+		IDKey:          "u_1234567890",
+		NameKey:        "test",
+		DescriptionKey: "bar",
+		ScopeIdKey:     "o_1234567890",
+	})
+
+	var accountIds []tftypes.Value
+	require.NoError(t, attributes[userAccountIDsKey].As(&accountIds))
+	require.Len(t, accountIds, 1)
+	var accountId string
+	require.NoError(t, accountIds[0].As(&accountId))
+	assert.Equal(t, "acctpw_1234567890", accountId)
+	assert.True(t, attributes["timeouts"].IsNull())
+}
+
+// TestScopeResourceStateCompatibility checks a state written by the SDK
+// version of boundary_scope is read by the framework version
+func TestScopeResourceStateCompatibility(t *testing.T) {
+	t.Parallel()
+
+	attributes := testUpgradeResourceState(t, "boundary_scope", `{
+		"auto_create_admin_role": true,
+		"auto_create_default_role": null,
+		"description": "bar",
+		"global_scope": null,
+		"id": "o_1234567890",
+		"name": "test",
+		"scope_id": "global",
+		"timeouts": {"create": null, "delete": "45m", "read": null, "update": null}
+	}`)
+	testAssertStringAttributes(t, attributes, map[string]string{
+		IDKey:          "o_1234567890",
+		NameKey:        "test",
+		DescriptionKey: "bar",
+		ScopeIdKey:     "global",
+	})
+
+	var autoCreateAdminRole bool
+	require.NoError(t, attributes[scopeAutoCreateAdminRole].As(&autoCreateAdminRole))
+	assert.True(t, autoCreateAdminRole)
+	assert.True(t, attributes[scopeAutoCreateDefaultRole].IsNull())
+	assert.True(t, attributes[scopeGlobalScopeKey].IsNull())
+
+	var timeouts map[string]tftypes.Value
+	require.NoError(t, attributes["timeouts"].As(&timeouts))
+	var deleteTimeout string
+	require.NoError(t, timeouts["delete"].As(&deleteTimeout))
+	assert.Equal(t, "45m", deleteTimeout)
+}
+
+// TestRoleResourceStateCompatibility checks a state written by the SDK
+// version of boundary_role is read by the framework version
+func TestRoleResourceStateCompatibility(t *testing.T) {
+	t.Parallel()
+
+	attributes := testUpgradeResourceState(t, "boundary_role", `{
+		"description": "",
+		"grant_scope_ids": ["this", "p_1234567890"],
+		"grant_strings": ["ids=*;type=*;actions=read"],
+		"id": "r_1234567890",
+		"name": "test",
+		"principal_ids": null,
+		"scope_id": "o_1234567890",
+		"timeouts": null
+	}`)
+	testAssertStringAttributes(t, attributes, map[string]string{
+		IDKey:      "r_1234567890",
+		NameKey:    "test",
+		ScopeIdKey: "o_1234567890",
+	})
+	// the SDK saved an empty description when it was not set
+	assert.True(t, attributes[DescriptionKey].IsNull())
+
+	for key, want := range map[string][]string{
+		roleGrantScopeIdsKey: {"p_1234567890", "this"},
+		roleGrantStringsKey:  {"ids=*;type=*;actions=read"},
+	} {
+		var values []tftypes.Value
+		require.NoError(t, attributes[key].As(&values), key)
+		got := make([]string, 0, len(values))
+		for _, v := range values {
+			var s string
+			require.NoError(t, v.As(&s), key)
+			got = append(got, s)
+		}
+		assert.ElementsMatch(t, want, got, key)
+	}
+	assert.True(t, attributes[rolePrincipalIdsKey].IsNull())
+	assert.True(t, attributes["timeouts"].IsNull())
+}
+
+// TestTargetResourceStateCompatibility checks a state written by the SDK
+// version of boundary_target is read by the framework version
+func TestTargetResourceStateCompatibility(t *testing.T) {
+	t.Parallel()
+
+	attributes := testUpgradeResourceState(t, "boundary_target", `{
+		"address": null,
+		"brokered_credential_source_ids": ["clvlt_1234567890"],
+		"default_client_port": 0,
+		"default_port": 22,
+		"description": "bar",
+		"egress_worker_filter": "",
+		"enable_session_recording": false,
+		"host_source_ids": ["hsst_1234567890"],
+		"id": "tssh_1234567890",
+		"ingress_worker_filter": null,
+		"injected_application_credential_source_ids": null,
+		"name": "test",
+		"scope_id": "p_1234567890",
+		"session_connection_limit": -1,
+		"session_handling": [{"mode": "wait_up_to", "timeout": "15m"}],
+		"session_max_seconds": 28800,
+		"storage_bucket_id": null,
+		"timeouts": {"create": null, "delete": "1h", "read": null, "update": null},
+		"type": "ssh",
+		"worker_filter": null
+	}`)
+	testAssertStringAttributes(t, attributes, map[string]string{
+		IDKey:          "tssh_1234567890",
+		NameKey:        "test",
+		DescriptionKey: "bar",
+		ScopeIdKey:     "p_1234567890",
+		TypeKey:        targetTypeSsh,
+	})
+
+	for key, want := range map[string]int64{
+		targetDefaultPortKey:            22,
+		targetSessionConnectionLimitKey: -1,
+		targetSessionMaxSecondsKey:      28800,
+	} {
+		var got big.Float
+		require.NoError(t, attributes[key].As(&got), key)
+		gotInt, _ := got.Int64()
+		assert.Equal(t, want, gotInt, key)
+	}
+	// the zero values saved by the SDK for the attributes not set are null
+	for _, key := range []string{
+		targetAddressKey,
+		targetDefaultClientPortKey,
+		targetEnableSessionRecordingKey,
+		targetInjectedAppCredentialSourceIdsKey,
+		targetStorageBucketIdKey,
+		targetWorkerEgressFilterKey,
+		targetWorkerFilterKey,
+	} {
+		assert.True(t, attributes[key].IsNull(), key)
+	}
+
+	var sessionHandling []tftypes.Value
+	require.NoError(t, attributes[targetSessionHandlingKey].As(&sessionHandling))
+	require.Len(t, sessionHandling, 1)
+	var block map[string]tftypes.Value
+	require.NoError(t, sessionHandling[0].As(&block))
+	testAssertStringAttributes(t, block, map[string]string{
+		targetSessionHandlingModeKey:    targetSessionHandlingWaitUpTo,
+		targetSessionHandlingTimeoutKey: "15m",
+	})
+
+	var timeouts map[string]tftypes.Value
+	require.NoError(t, attributes["timeouts"].As(&timeouts))
+	var deleteTimeout string
+	require.NoError(t, timeouts["delete"].As(&deleteTimeout))
+	assert.Equal(t, "1h", deleteTimeout)
+}
+
+// testPlanUpgradedState plans a configuration setting the configured
+// attributes of rawState, a state written by the SDK version of typeName,
+// and returns the prior and the planned states
+func testPlanUpgradedState(t *testing.T, typeName, rawState string, configured ...string) (tftypes.Value, tftypes.Value) {
+	t.Helper()
+	ctx := context.Background()
+	server := testMuxServer(t)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	resourceSchema := schemaResp.ResourceSchemas[typeName]
+	require.NotNil(t, resourceSchema)
+	typ := resourceSchema.ValueType().(tftypes.Object)
+
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: []byte(rawState)},
+	})
+	require.NoError(t, err)
+	require.Empty(t, upgradeResp.Diagnostics)
+	prior, err := upgradeResp.UpgradedState.Unmarshal(typ)
+	require.NoError(t, err)
+	var priorAttributes map[string]tftypes.Value
+	require.NoError(t, prior.As(&priorAttributes))
+
+	// the configuration and the new state proposed by Terraform, which keeps
+	// the prior values of the computed attributes that are not configured
+	config := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	proposed := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attributeType := range typ.AttributeTypes {
+		config[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for _, b := range resourceSchema.Block.BlockTypes {
+		if b.Nesting == tfprotov5.SchemaNestedBlockNestingModeList {
+			config[b.TypeName] = tftypes.NewValue(typ.AttributeTypes[b.TypeName], []tftypes.Value{})
+		}
+	}
+	for _, name := range configured {
+		require.Contains(t, priorAttributes, name)
+		config[name] = priorAttributes[name]
+	}
+	for name, v := range config {
+		proposed[name] = v
+	}
+	for _, a := range resourceSchema.Block.Attributes {
+		if a.Computed && config[a.Name].IsNull() {
+			proposed[a.Name] = priorAttributes[a.Name]
+		}
+	}
+
+	configValue, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, config))
+	require.NoError(t, err)
+	proposedValue, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, proposed))
+	require.NoError(t, err)
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       upgradeResp.UpgradedState,
+		ProposedNewState: &proposedValue,
+		Config:           &configValue,
+	})
+	require.NoError(t, err)
+	for _, d := range planResp.Diagnostics {
+		assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+	assert.Empty(t, planResp.RequiresReplace)
+	planned, err := planResp.PlannedState.Unmarshal(typ)
+	require.NoError(t, err)
+	return prior, planned
+}
+
+// TestPlanUpgradedState checks no change is planned for the states written
+// by the SDK versions of the framework resources, which saved the zero value
+// of the optional attributes that are not set
+func TestPlanUpgradedState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		typeName   string
+		rawState   string
+		configured []string
+	}{
+		{
+			// boundary_scope.org1 of fooOrg
+			typeName: "boundary_scope",
+			rawState: `{
+				"auto_create_admin_role": false,
+				"auto_create_default_role": false,
+				"description": "",
+				"global_scope": false,
+				"id": "o_1234567890",
+				"name": "org1",
+				"scope_id": "global",
+				"timeouts": null
+			}`,
+			configured: []string{NameKey, ScopeIdKey},
+		},
+		{
+			// boundary_role.org1_admin of fooOrg
+			typeName: "boundary_role",
+			rawState: `{
+				"description": "",
+				"grant_scope_ids": ["o_1234567890"],
+				"grant_strings": ["ids=*;type=*;actions=*"],
+				"id": "r_1234567890",
+				"name": "",
+				"principal_ids": ["u_auth"],
+				"scope_id": "global",
+				"timeouts": null
+			}`,
+			configured: []string{ScopeIdKey, roleGrantScopeIdsKey, roleGrantStringsKey, rolePrincipalIdsKey},
+		},
+		{
+			typeName: "boundary_user",
+			rawState: `{
+				"account_ids": [],
+				"description": "",
+				"id": "u_1234567890",
+				"name": "foo",
+				"scope_id": "o_1234567890",
+				"timeouts": null
+			}`,
+			configured: []string{NameKey, ScopeIdKey},
+		},
+		{
+			typeName: "boundary_target",
+			rawState: `{
+				"address": "127.0.0.1",
+				"brokered_credential_source_ids": [],
+				"default_client_port": 0,
+				"default_port": 22,
+				"description": "",
+				"egress_worker_filter": "",
+				"enable_session_recording": false,
+				"host_source_ids": [],
+				"id": "ttcp_1234567890",
+				"ingress_worker_filter": "",
+				"injected_application_credential_source_ids": [],
+				"name": "test",
+				"scope_id": "p_1234567890",
+				"session_connection_limit": -1,
+				"session_handling": [],
+				"session_max_seconds": 28800,
+				"storage_bucket_id": "",
+				"timeouts": null,
+				"type": "tcp",
+				"worker_filter": ""
+			}`,
+			configured: []string{NameKey, TypeKey, ScopeIdKey, targetAddressKey, targetDefaultPortKey},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			prior, planned := testPlanUpgradedState(t, tt.typeName, tt.rawState, tt.configured...)
+			diffs, err := prior.Diff(planned)
+			require.NoError(t, err)
+			assert.Empty(t, diffs)
+		})
+	}
+}
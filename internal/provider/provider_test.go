@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/cap/oidc"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/hashicorp/go-kms-wrapping/v2/aead"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -36,12 +38,16 @@ var (
 	tcRecoveryKey = "7xtkEoS5EXPbgynwd+dDLHopaCqK8cq0Rpep4eooaTs="
 )
 
-func providerFactories(p **schema.Provider) map[string]func() (*schema.Provider, error) {
+func providerFactories(p **schema.Provider) map[string]func() (tfprotov5.ProviderServer, error) {
 	// TODO: eventually rework this to real factories...
 	*p = New()
-	return map[string]func() (*schema.Provider, error){
-		"boundary": func() (*schema.Provider, error) {
-			return *p, nil
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"boundary": func() (tfprotov5.ProviderServer, error) {
+			muxServer, err := newMuxServer(context.Background(), *p)
+			if err != nil {
+				return nil, err
+			}
+			return muxServer(), nil
 		},
 	}
 }
@@ -172,6 +178,33 @@ provider "boundary" {
 	return strings.Join(c, "\n")
 }
 
+// testConfigWithRotatedRecovery gives a recovery key the controller does not
+// know about before the current one, like during a recovery key rotation
+func testConfigWithRotatedRecovery(url string, res ...string) string {
+	provider := fmt.Sprintf(`
+provider "boundary" {
+	addr             = "%s"
+	recovery_kms_hcl = <<DOC
+	kms "aead" {
+		purpose = "recovery"
+		aead_type = "aes-gcm"
+		key = "8fZBjCUfN0TzjEGLQldGY4+iE9AkOvCfjh7+p0GtRBQ="
+		key_id = "global_recovery_next"
+	}
+	kms "aead" {
+		purpose = ["recovery", "config"]
+		aead_type = "aes-gcm"
+		key = "%s"
+		key_id = "global_recovery"
+	}
+	DOC
+}`, url, tcRecoveryKey)
+
+	c := []string{provider}
+	c = append(c, res...)
+	return strings.Join(c, "\n")
+}
+
 func testConfigWithLDAPAuthMethod(url string, loginName string, password string, res ...string) string {
 	provider := fmt.Sprintf(`
 provider "boundary" {
@@ -219,8 +252,8 @@ func TestConfigWithLdapAuthMethod(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAuthMethodResourceDestroy(t, provider, ldapAuthMethodType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAuthMethodResourceDestroy(t, provider, ldapAuthMethodType),
 		Steps: []resource.TestStep{
 			{
 				// create ldap auth method
@@ -254,9 +287,9 @@ func TestConfigWithDefaultAuthMethod(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckScopeResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfigWithDefaultAuthMethod(url, fooOrg, firstProjectFoo, secondProject),
@@ -276,9 +309,9 @@ func TestConfigWithDeprecatedAuthMethod(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckScopeResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfigWithDeprecatedAuthMethod(url, fooOrg, firstProjectFoo, secondProject),
@@ -298,8 +331,8 @@ func TestConfigWithoutAMPWCredentials(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config:      testConfigWithoutAMPWCredentials(url, fooOrg, firstProjectFoo, secondProject),
@@ -316,8 +349,8 @@ func TestConfigWithOIDCAuthMethod(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
 		Steps: []resource.TestStep{
 			{
 				Config:      testConfigWithOIDCAuthMethod(url, fooOrg, firstProjectFoo, secondProject),
@@ -342,8 +375,8 @@ func TestRecoveryWithOIDCDefaultAuthMethod(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAuthMethodResourceDestroy(t, provider, oidcAuthMethodType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAuthMethodResourceDestroy(t, provider, oidcAuthMethodType),
 		Steps: []resource.TestStep{
 			{
 				// create auth method
@@ -376,6 +409,42 @@ func TestRecoveryWithOIDCDefaultAuthMethod(t *testing.T) {
 	})
 }
 
+func TestRecoveryKmsRotation(t *testing.T) {
+	wrapper := testWrapper(context.Background(), t, tcRecoveryKey)
+	tc := controller.NewTestController(t, append(tcConfig, controller.WithRecoveryKms(wrapper))...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the first recovery key is rejected and the second one used
+				Config: testConfigWithRotatedRecovery(url, fooOrg),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeResourceExists(provider, "boundary_scope.org1"),
+					testProviderRecoveryKmsKeyId(provider, "global_recovery", "global_recovery_next"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderRecoveryKmsKeyId(testProvider *schema.Provider, keyId string, rejected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		md := testProvider.Meta().(*metaData)
+		if md.recoveryKmsKeyId != keyId {
+			return fmt.Errorf("recovery KMS key %q used, expected %q", md.recoveryKmsKeyId, keyId)
+		}
+		if !slices.Equal(md.recoveryKmsRejectedKeyIds, rejected) {
+			return fmt.Errorf("recovery KMS keys %q rejected, expected %q", md.recoveryKmsRejectedKeyIds, rejected)
+		}
+		return nil
+	}
+}
+
 func testProviderTokenExists(testProvider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		md := testProvider.Meta().(*metaData)
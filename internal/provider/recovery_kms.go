@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	"github.com/hashicorp/hcl"
+)
+
+// recoveryKmsPluginsConfig is the plugins stanza that recovery_kms_hcl may
+// contain next to its kms blocks
+type recoveryKmsPluginsConfig struct {
+	Plugins struct {
+		ExecutionDir string `hcl:"execution_dir"`
+	} `hcl:"plugins"`
+}
+
+// recoveryKms is a configured recovery KMS wrapper
+type recoveryKms struct {
+	wrapper wrapping.Wrapper
+	keyId   string
+	cleanup func() error
+}
+
+// parseRecoveryKmses returns the kms blocks of recoveryHcl that have the
+// recovery purpose, in the order they are given
+func parseRecoveryKmses(recoveryHcl string) ([]*configutil.KMS, error) {
+	kmses, err := configutil.ParseKMSes(recoveryHcl, configutil.WithMaxKmsBlocks(-1))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing KMS HCL: %w", err)
+	}
+	var recovery []*configutil.KMS
+	for _, kms := range kmses {
+		if slices.Contains(kms.Purpose, recoveryKmsPurpose) {
+			recovery = append(recovery, kms)
+		}
+	}
+	return recovery, nil
+}
+
+// configureRecoveryKmses configures a wrapper for each of the recovery kms
+// blocks. The plugins stanza of recoveryHcl is honored the same way
+// wrapper.GetWrapperFromHcl does. On error the wrappers configured so far are
+// cleaned up.
+func configureRecoveryKmses(ctx context.Context, recoveryHcl string, kmses []*configutil.KMS, pluginOpts []pluginutil.Option) ([]*recoveryKms, error) {
+	var conf recoveryKmsPluginsConfig
+	if err := hcl.Decode(&conf, recoveryHcl); err != nil {
+		return nil, fmt.Errorf("error parsing plugins stanza: %w", err)
+	}
+	if conf.Plugins.ExecutionDir != "" {
+		pluginOpts = append(pluginOpts, pluginutil.WithPluginExecutionDirectory(conf.Plugins.ExecutionDir))
+	}
+
+	var configured []*recoveryKms
+	for _, kms := range kmses {
+		wrapper, cleanup, err := configutil.ConfigureWrapper(ctx, kms, nil, nil, configutil.WithPluginOptions(pluginOpts...))
+		if err == nil && wrapper == nil {
+			err = fmt.Errorf("kms type %q cannot be used for recovery", kms.Type)
+		}
+		var keyId string
+		if err == nil {
+			keyId, err = wrapper.KeyId(ctx)
+		}
+		configured = append(configured, &recoveryKms{wrapper: wrapper, keyId: keyId, cleanup: cleanup})
+		if err != nil {
+			cleanupRecoveryKmses(configured)
+			return nil, fmt.Errorf("error configuring kms %q: %w", kms.Type, err)
+		}
+	}
+	return configured, nil
+}
+
+// selectRecoveryKms returns the first of the recovery KMSes the controller
+// accepts, along with the key IDs that were rejected before it. The KMSes are
+// tried by reading the global scope, which the recovery user is always allowed
+// to do. A single KMS is returned without being tried.
+func selectRecoveryKms(ctx context.Context, client *api.Client, kmses []*recoveryKms) (*recoveryKms, []string, error) {
+	if len(kmses) == 1 {
+		return kmses[0], nil, nil
+	}
+
+	var rejected []string
+	for _, kms := range kmses {
+		c := client.Clone()
+		c.SetRecoveryKmsWrapper(kms.wrapper)
+		_, err := scopes.NewClient(c).Read(ctx, globalScopeId)
+		if err == nil {
+			return kms, rejected, nil
+		}
+		apiErr := api.AsServerError(err)
+		if apiErr == nil {
+			return nil, nil, fmt.Errorf("error checking recovery KMS key %q: %w", kms.keyId, err)
+		}
+		switch apiErr.Response().StatusCode() {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			log.Printf("[DEBUG] recovery KMS key %q was rejected by the controller", kms.keyId)
+			rejected = append(rejected, kms.keyId)
+		default:
+			return nil, nil, fmt.Errorf("error checking recovery KMS key %q: %w", kms.keyId, err)
+		}
+	}
+	return nil, rejected, fmt.Errorf("none of the recovery KMS keys %q were accepted by the controller", rejected)
+}
+
+// cleanupRecoveryKmses stops the plugins of the given recovery KMSes
+func cleanupRecoveryKmses(kmses []*recoveryKms) error {
+	var errs []error
+	for _, kms := range kmses {
+		if kms.cleanup == nil {
+			continue
+		}
+		if err := kms.cleanup(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	kms_plugin_assets "github.com/hashicorp/terraform-provider-boundary/plugins/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecoveryKmsRotationHcl = `
+kms "aead" {
+	purpose   = "recovery"
+	aead_type = "aes-gcm"
+	key       = "8fZBjCUfN0TzjEGLQldGY4+iE9AkOvCfjh7+p0GtRBQ="
+	key_id    = "global_recovery_next"
+}
+
+kms "aead" {
+	purpose   = "root"
+	aead_type = "aes-gcm"
+	key       = "sP1fnF5Xz85RrXyELHFeZg9Ad2qt4Z4bgNHVGtD6ung="
+	key_id    = "global_root"
+}
+
+kms "aead" {
+	purpose   = ["recovery", "config"]
+	aead_type = "aes-gcm"
+	key       = "7xtkEoS5EXPbgynwd+dDLHopaCqK8cq0Rpep4eooaTs="
+	key_id    = "global_recovery"
+}
+`
+
+func TestConfigureRecoveryKmses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	kmses, err := parseRecoveryKmses(testRecoveryKmsRotationHcl)
+	require.NoError(t, err)
+	require.Len(t, kmses, 2)
+
+	configured, err := configureRecoveryKmses(ctx, testRecoveryKmsRotationHcl, kmses, []pluginutil.Option{
+		pluginutil.WithPluginsMap(kms_plugin_assets.BuiltinKmsPlugins()),
+	})
+	require.NoError(t, err)
+	defer cleanupRecoveryKmses(configured)
+
+	require.Len(t, configured, 2)
+	assert.Equal(t, "global_recovery_next", configured[0].keyId)
+	assert.Equal(t, "global_recovery", configured[1].keyId)
+
+	// A single recovery KMS is used without asking the controller
+	selected, rejected, err := selectRecoveryKms(ctx, nil, configured[1:])
+	require.NoError(t, err)
+	assert.Equal(t, configured[1], selected)
+	assert.Empty(t, rejected)
+}
@@ -228,7 +228,9 @@ func resourceAccountLdapUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if len(opts) > 0 {
 		opts = append(opts, accounts.WithAutomaticVersioning(true))
-		_, err := aClient.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*accounts.AccountUpdateResult, error) {
+			return aClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating account: %v", err)
 		}
@@ -106,8 +106,8 @@ func TestAccLdapAccount(t *testing.T) {
 	var provider *schema.Provider
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAccountResourceDestroy(t, provider, baseAccountType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAccountResourceDestroy(t, provider, baseAccountType),
 		Steps: []resource.TestStep{
 			{
 				// create
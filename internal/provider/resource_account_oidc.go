@@ -181,7 +181,9 @@ func resourceAccountOidcUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if len(opts) > 0 {
 		opts = append(opts, accounts.WithAutomaticVersioning(true))
-		aur, err := aClient.Update(ctx, d.Id(), 0, opts...)
+		aur, err := retryOnVersionConflict(ctx, func() (*accounts.AccountUpdateResult, error) {
+			return aClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating account: %v", err)
 		}
@@ -197,7 +199,7 @@ func resourceAccountOidcDelete(ctx context.Context, d *schema.ResourceData, meta
 	aClient := accounts.NewClient(md.client)
 
 	_, err := aClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting account: %v", err)
 	}
 
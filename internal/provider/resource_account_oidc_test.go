@@ -63,8 +63,8 @@ func TestAccOidcAccount(t *testing.T) {
 	updateConfig := fmt.Sprintf(fooAccountOidc, tp.Addr(), tpCert, fooAccountOidcDescUpdate, tp.ExpectedSubject(), tp.Addr())
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAccountResourceDestroy(t, provider, oidcAccountType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAccountResourceDestroy(t, provider, oidcAccountType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -237,7 +237,9 @@ func resourceAccountPasswordUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if len(opts) > 0 {
 		opts = append(opts, accounts.WithAutomaticVersioning(true))
-		_, err := aClient.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*accounts.AccountUpdateResult, error) {
+			return aClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating account: %v", err)
 		}
@@ -261,7 +263,7 @@ func resourceAccountPasswordDelete(ctx context.Context, d *schema.ResourceData,
 	aClient := accounts.NewClient(md.client)
 
 	_, err := aClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting account: %v", err)
 	}
 
@@ -80,8 +80,8 @@ func TestAccAccount(t *testing.T) {
 	var provider *schema.Provider
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAccountResourceDestroy(t, provider, passwordAccountType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAccountResourceDestroy(t, provider, passwordAccountType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -33,6 +33,7 @@ func resourceAliasTarget() *schema.Resource {
 		ReadContext:   resourceTargetAliasRead,
 		UpdateContext: resourceTargetAliasUpdate,
 		DeleteContext: resourceTargetAliasDelete,
+		CustomizeDiff: resourceAliasTargetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -60,9 +61,10 @@ func resourceAliasTarget() *schema.Resource {
 				ForceNew:    true,
 			},
 			ValueKey: {
-				Description: "The value of the alias.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The value of the alias. It must be a valid DNS name and not be used by another alias.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateTargetAliasValueFunc,
 			},
 			DestinationIdKey: {
 				Description: "The destination of the alias.",
@@ -72,9 +74,10 @@ func resourceAliasTarget() *schema.Resource {
 
 			// Target specific configurable parameters
 			aliasTargetAuthorizeSessionHostIdKey: {
-				Description: "The host id to pass to Boundary when performing an authorize session action.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description: "The host id to pass to Boundary when performing an authorize session action. " +
+					"The host must be in one of the host sets of the destination target.",
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 
 			TypeKey: {
@@ -258,7 +261,9 @@ func resourceTargetAliasUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if len(opts) > 0 {
 		opts = append(opts, aliases.WithAutomaticVersioning(true))
-		alur, err := alClient.Update(ctx, d.Id(), 0, opts...)
+		alur, err := retryOnVersionConflict(ctx, func() (*aliases.AliasUpdateResult, error) {
+			return alClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
@@ -276,7 +281,7 @@ func resourceTargetAliasDelete(ctx context.Context, d *schema.ResourceData, meta
 	client := aliases.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting alias: %v", err)
 	}
 
@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	aliasTargetSetAliasesKey                  = "aliases"
+	aliasTargetSetAuthorizeSessionHostIdsKey  = "authorize_session_host_ids"
+	aliasTargetSetAliasIdsKey                 = "alias_ids"
+	aliasTargetSetAuthorizeSessionHostIdsDesc = "A map of alias values to the host ID to pass to Boundary when " +
+		"performing an authorize session action through that alias. Every key must also be a key of `aliases`."
+)
+
+func resourceAliasTargetSet() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target alias set resource allows you to manage many Boundary target aliases of a scope " +
+			"in a single resource, e.g. one DNS-style alias per host of a fleet. Each alias is a regular " +
+			"Boundary target alias and should not also be managed by a `boundary_alias_target` resource.",
+
+		CreateContext: resourceAliasTargetSetCreate,
+		ReadContext:   resourceAliasTargetSetRead,
+		UpdateContext: resourceAliasTargetSetUpdate,
+		DeleteContext: resourceAliasTargetSetDelete,
+		CustomizeDiff: resourceAliasTargetSetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the alias set.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope ID. Org scopes are not supported for aliases.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if err := validateTargetAliasScope(i.(string)); err != nil {
+						return nil, []error{err}
+					}
+					return nil, nil
+				},
+			},
+			aliasTargetSetAliasesKey: {
+				Description: "A map of alias values to the ID of the target each alias points to.",
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			aliasTargetSetAuthorizeSessionHostIdsKey: {
+				Description: aliasTargetSetAuthorizeSessionHostIdsDesc,
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			aliasTargetSetAliasIdsKey: {
+				Description: "A map of alias values to the ID of the alias created for them.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// aliasTargetSetDiffGetter is satisfied by *schema.ResourceDiff.
+type aliasTargetSetDiffGetter interface {
+	resourceDataGetter
+	NewValueKnown(string) bool
+}
+
+// validateAliasTargetSet checks the alias values and that authorize session
+// host IDs are only set for aliases that are part of the set
+func validateAliasTargetSet(d aliasTargetSetDiffGetter) error {
+	if !d.NewValueKnown(aliasTargetSetAliasesKey) || !d.NewValueKnown(aliasTargetSetAuthorizeSessionHostIdsKey) {
+		return nil
+	}
+	values, _ := d.GetOk(aliasTargetSetAliasesKey)
+	hostIds, _ := d.GetOk(aliasTargetSetAuthorizeSessionHostIdsKey)
+	aliasValues, _ := values.(map[string]interface{})
+	aliasHostIds, _ := hostIds.(map[string]interface{})
+
+	sorted := make([]string, 0, len(aliasValues))
+	for value := range aliasValues {
+		sorted = append(sorted, value)
+	}
+	sort.Strings(sorted)
+	for _, value := range sorted {
+		if err := validateTargetAliasValue(value); err != nil {
+			return err
+		}
+	}
+
+	var unknown []string
+	for value := range aliasHostIds {
+		if _, ok := aliasValues[value]; !ok {
+			unknown = append(unknown, value)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("%q has values that are not in %q: %v", aliasTargetSetAuthorizeSessionHostIdsKey, aliasTargetSetAliasesKey, unknown)
+	}
+	return nil
+}
+
+// aliasTargetSetEntry is the desired state of a single alias of the set
+type aliasTargetSetEntry struct {
+	destinationId string
+	hostId        string
+}
+
+// expandAliasTargetSet returns the aliases of the set keyed by their value
+func expandAliasTargetSet(values, hostIds map[string]interface{}) map[string]aliasTargetSetEntry {
+	entries := make(map[string]aliasTargetSetEntry, len(values))
+	for value, destinationId := range values {
+		hostId, _ := hostIds[value].(string)
+		entries[value] = aliasTargetSetEntry{
+			destinationId: destinationId.(string),
+			hostId:        hostId,
+		}
+	}
+	return entries
+}
+
+// diffAliasTargetSet returns, sorted, the values of the aliases to create,
+// update and delete to go from current to desired
+func diffAliasTargetSet(current, desired map[string]aliasTargetSetEntry) (create, update, remove []string) {
+	for value, entry := range desired {
+		currentEntry, ok := current[value]
+		switch {
+		case !ok:
+			create = append(create, value)
+		case currentEntry != entry:
+			update = append(update, value)
+		}
+	}
+	for value := range current {
+		if _, ok := desired[value]; !ok {
+			remove = append(remove, value)
+		}
+	}
+	sort.Strings(create)
+	sort.Strings(update)
+	sort.Strings(remove)
+	return create, update, remove
+}
+
+func aliasTargetSetEntries(d *schema.ResourceData) map[string]aliasTargetSetEntry {
+	return expandAliasTargetSet(
+		d.Get(aliasTargetSetAliasesKey).(map[string]interface{}),
+		d.Get(aliasTargetSetAuthorizeSessionHostIdsKey).(map[string]interface{}),
+	)
+}
+
+func resourceAliasTargetSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The ID is set first so the aliases created before a failure are kept in
+	// the state and cleaned up by Terraform
+	d.SetId(id.UniqueId())
+
+	diags := applyAliasTargetSet(ctx, d, meta, nil, aliasTargetSetEntries(d))
+	if diags.HasError() {
+		return diags
+	}
+	return resourceAliasTargetSetRead(ctx, d, meta)
+}
+
+func resourceAliasTargetSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	alClient := aliases.NewClient(md.client)
+
+	values := map[string]string{}
+	hostIds := map[string]string{}
+	aliasIds := map[string]string{}
+	for value, aliasId := range d.Get(aliasTargetSetAliasIdsKey).(map[string]interface{}) {
+		alrr, err := alClient.Read(ctx, aliasId.(string))
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+				// the alias was deleted outside of Terraform, it will be recreated
+				continue
+			}
+			return diag.Errorf("error reading alias %q: %v", value, err)
+		}
+		if alrr == nil || alrr.Item == nil {
+			return diag.Errorf("alias %q nil after read", value)
+		}
+
+		// The value is read back so an alias renamed outside of Terraform is
+		// shown as a change
+		alias := alrr.Item
+		aliasIds[alias.Value] = alias.Id
+		values[alias.Value] = alias.DestinationId
+		if hostId := targetAliasHostId(alias); hostId != "" {
+			hostIds[alias.Value] = hostId
+		}
+	}
+
+	if err := d.Set(aliasTargetSetAliasesKey, values); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(aliasTargetSetAuthorizeSessionHostIdsKey, hostIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(aliasTargetSetAliasIdsKey, aliasIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAliasTargetSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges(aliasTargetSetAliasesKey, aliasTargetSetAuthorizeSessionHostIdsKey) {
+		return nil
+	}
+
+	oldValues, newValues := d.GetChange(aliasTargetSetAliasesKey)
+	oldHostIds, newHostIds := d.GetChange(aliasTargetSetAuthorizeSessionHostIdsKey)
+
+	diags := applyAliasTargetSet(ctx, d, meta,
+		expandAliasTargetSet(oldValues.(map[string]interface{}), oldHostIds.(map[string]interface{})),
+		expandAliasTargetSet(newValues.(map[string]interface{}), newHostIds.(map[string]interface{})),
+	)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceAliasTargetSetRead(ctx, d, meta)
+}
+
+func resourceAliasTargetSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	alClient := aliases.NewClient(md.client)
+
+	aliasIds := d.Get(aliasTargetSetAliasIdsKey).(map[string]interface{})
+	values := make([]string, 0, len(aliasIds))
+	for value := range aliasIds {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	for _, value := range values {
+		if _, err := alClient.Delete(ctx, aliasIds[value].(string)); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return diag.Errorf("error deleting alias %q: %v", value, err)
+		}
+	}
+
+	return nil
+}
+
+// applyAliasTargetSet creates, updates and deletes aliases to go from current
+// to desired. The alias_ids attribute is kept up to date as aliases are created and
+// deleted so it stays accurate when an error interrupts the changes.
+func applyAliasTargetSet(ctx context.Context, d *schema.ResourceData, meta interface{}, current, desired map[string]aliasTargetSetEntry) diag.Diagnostics {
+	md := meta.(*metaData)
+	alClient := aliases.NewClient(md.client)
+	scopeId := d.Get(ScopeIdKey).(string)
+
+	aliasIds := map[string]string{}
+	for value, aliasId := range d.Get(aliasTargetSetAliasIdsKey).(map[string]interface{}) {
+		aliasIds[value] = aliasId.(string)
+	}
+	setAliasIds := func() diag.Diagnostics {
+		if err := d.Set(aliasTargetSetAliasIdsKey, aliasIds); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	create, update, remove := diffAliasTargetSet(current, desired)
+
+	// Aliases are deleted first so a value moved to another alias of the set
+	// does not collide with itself
+	for _, value := range remove {
+		if aliasId, ok := aliasIds[value]; ok {
+			if _, err := alClient.Delete(ctx, aliasId); err != nil {
+				if !isNotFoundError(err) {
+					return append(setAliasIds(), diag.Errorf("error deleting alias %q: %v", value, err)...)
+				}
+			}
+		}
+		delete(aliasIds, value)
+	}
+
+	for _, value := range update {
+		aliasId, ok := aliasIds[value]
+		if !ok {
+			// the alias is gone, it is created again below
+			create = append(create, value)
+			continue
+		}
+		entry := desired[value]
+		opts := []aliases.Option{
+			aliases.WithAutomaticVersioning(true),
+			aliases.WithDestinationId(entry.destinationId),
+			aliases.DefaultTargetAliasAuthorizeSessionArgumentsHostId(),
+		}
+		if entry.hostId != "" {
+			opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(entry.hostId))
+		}
+		if _, err := retryOnVersionConflict(ctx, func() (*aliases.AliasUpdateResult, error) {
+			return alClient.Update(ctx, aliasId, 0, opts...)
+		}); err != nil {
+			return append(setAliasIds(), diag.Errorf("error updating alias %q: %v", value, err)...)
+		}
+	}
+
+	for _, value := range create {
+		entry := desired[value]
+		opts := []aliases.Option{
+			aliases.WithValue(value),
+			aliases.WithDestinationId(entry.destinationId),
+		}
+		if entry.hostId != "" {
+			opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(entry.hostId))
+		}
+		alcr, err := alClient.Create(ctx, aliasTypeTarget, scopeId, opts...)
+		if err != nil {
+			return append(setAliasIds(), diag.Errorf("error creating alias %q: %v", value, err)...)
+		}
+		if alcr == nil || alcr.Item == nil {
+			return append(setAliasIds(), diag.Errorf("nil alias %q after create", value)...)
+		}
+		aliasIds[value] = alcr.Item.Id
+	}
+
+	return setAliasIds()
+}
+
+// targetAliasHostId returns the authorize session host ID of a target alias
+func targetAliasHostId(alias *aliases.Alias) string {
+	if alias.Attributes == nil {
+		return ""
+	}
+	attrs, err := aliases.AttributesMapToTargetAliasAttributes(alias.Attributes)
+	if err != nil || attrs.AuthorizeSessionArguments == nil {
+		return ""
+	}
+	return attrs.AuthorizeSessionArguments.HostId
+}
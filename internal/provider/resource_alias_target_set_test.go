@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const targetAliasSetResc = "boundary_alias_target_set.example"
+
+var (
+	targetAliasSet = `
+resource "boundary_alias_target_set" "example" {
+	scope_id = "global"
+	aliases = {
+		"one.fleet.example" = boundary_target.foo.id
+		"two.fleet.example" = boundary_target.foo.id
+	}
+	authorize_session_host_ids = {
+		"one.fleet.example" = boundary_host_static.alias_one.id
+	}
+	depends_on = [boundary_target.foo]
+}`
+
+	targetAliasSetUpdate = `
+resource "boundary_alias_target_set" "example" {
+	scope_id = "global"
+	aliases = {
+		"one.fleet.example"   = boundary_target.foo.id
+		"three.fleet.example" = boundary_target.foo.id
+	}
+	authorize_session_host_ids = {
+		"one.fleet.example" = boundary_host_static.alias_two.id
+	}
+	depends_on = [boundary_target.foo]
+}`
+
+	targetAliasSetHostNotInTarget = `
+resource "boundary_alias_target_set" "example" {
+	scope_id = "global"
+	aliases = {
+		"one.fleet.example" = boundary_target.foo.id
+	}
+	authorize_session_host_ids = {
+		"one.fleet.example" = boundary_host_static.alias_other.id
+	}
+	depends_on = [boundary_target.foo]
+}`
+
+	targetAliasSetCollision = `
+resource "boundary_alias_target" "existing" {
+	scope_id       = "global"
+	value          = "taken.fleet.example"
+	destination_id = boundary_target.foo.id
+}`
+
+	targetAliasSetCollisionUpdate = `
+resource "boundary_alias_target" "existing" {
+	scope_id       = "global"
+	value          = "taken.fleet.example"
+	destination_id = boundary_target.foo.id
+}
+
+resource "boundary_alias_target_set" "example" {
+	scope_id = "global"
+	aliases = {
+		"taken.fleet.example" = boundary_target.foo.id
+	}
+	depends_on = [boundary_alias_target.existing]
+}`
+
+	targetAliasSetUnknownHostId = `
+resource "boundary_alias_target_set" "example" {
+	scope_id = "global"
+	aliases = {
+		"one.fleet.example" = boundary_target.foo.id
+	}
+	authorize_session_host_ids = {
+		"two.fleet.example" = boundary_host_static.alias_one.id
+	}
+	depends_on = [boundary_target.foo]
+}`
+)
+
+func TestAccAliasTargetSet(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	var aliasIds []string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAliasTargetSetDestroy(t, provider, &aliasIds),
+		Steps: []resource.TestStep{
+			{
+				// the target, its hosts and an alias using a value are
+				// created first so the validation can look them up
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSetCollision),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSetCollision, targetAliasSetUnknownHostId),
+				ExpectError: regexp.MustCompile(`values that are not in "aliases"`),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSetCollision, targetAliasSetHostNotInTarget),
+				ExpectError: regexp.MustCompile(`is not in any host set of destination target`),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSetCollisionUpdate),
+				ExpectError: regexp.MustCompile(`alias value "taken.fleet.example" is already used by alias`),
+			},
+			{
+				// create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(targetAliasSetResc, aliasTargetSetAliasesKey+".%", "2"),
+					resource.TestCheckResourceAttrPair(targetAliasSetResc, aliasTargetSetAliasesKey+".one.fleet.example", "boundary_target.foo", IDKey),
+					resource.TestCheckResourceAttrPair(targetAliasSetResc, aliasTargetSetAuthorizeSessionHostIdsKey+".one.fleet.example", "boundary_host_static.alias_one", IDKey),
+					resource.TestCheckResourceAttr(targetAliasSetResc, aliasTargetSetAliasIdsKey+".%", "2"),
+					testAccCheckAliasTargetSetExists(provider, targetAliasSetResc, &aliasIds),
+				),
+			},
+			{
+				// add, remove and update aliases
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSetUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(targetAliasSetResc, aliasTargetSetAliasesKey+".%", "2"),
+					resource.TestCheckNoResourceAttr(targetAliasSetResc, aliasTargetSetAliasesKey+".two.fleet.example"),
+					resource.TestCheckResourceAttrPair(targetAliasSetResc, aliasTargetSetAliasesKey+".three.fleet.example", "boundary_target.foo", IDKey),
+					resource.TestCheckResourceAttrPair(targetAliasSetResc, aliasTargetSetAuthorizeSessionHostIdsKey+".one.fleet.example", "boundary_host_static.alias_two", IDKey),
+					testAccCheckAliasTargetSetExists(provider, targetAliasSetResc, &aliasIds),
+				),
+			},
+			{
+				// an alias deleted outside of Terraform is created again
+				PreConfig: func() { aliasTargetSetExternalDelete(t, provider, aliasIds[0]) },
+				Config:    testConfig(url, fooOrg, firstProjectFoo, fooBarTarget, targetAliasSetUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(targetAliasSetResc, aliasTargetSetAliasIdsKey+".%", "2"),
+					testAccCheckAliasTargetSetExists(provider, targetAliasSetResc, &aliasIds),
+				),
+			},
+		},
+	})
+}
+
+func aliasTargetSetExternalDelete(t *testing.T, testProvider *schema.Provider, id string) {
+	md := testProvider.Meta().(*metaData)
+	if _, err := aliases.NewClient(md.client).Delete(context.Background(), id); err != nil {
+		t.Fatal(fmt.Errorf("got an error deleting %q: %w", id, err))
+	}
+}
+
+// testAccCheckAliasTargetSetExists checks every alias of the set exists and
+// records their IDs
+func testAccCheckAliasTargetSetExists(testProvider *schema.Provider, name string, aliasIds *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		c := aliases.NewClient(md.client)
+
+		*aliasIds = nil
+		prefix := aliasTargetSetAliasIdsKey + "."
+		for k, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, prefix) || k == prefix+"%" {
+				continue
+			}
+			alrr, err := c.Read(context.Background(), id)
+			if err != nil {
+				return fmt.Errorf("got an error reading %q: %w", id, err)
+			}
+			if value := strings.TrimPrefix(k, prefix); alrr.Item.Value != value {
+				return fmt.Errorf("alias %q has value %q, expected %q", id, alrr.Item.Value, value)
+			}
+			*aliasIds = append(*aliasIds, id)
+		}
+		if len(*aliasIds) == 0 {
+			return fmt.Errorf("no alias IDs set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAliasTargetSetDestroy(t *testing.T, testProvider *schema.Provider, aliasIds *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+		c := aliases.NewClient(md.client)
+
+		for _, id := range *aliasIds {
+			_, err := c.Read(context.Background(), id)
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return fmt.Errorf("didn't get a 404 when reading destroyed target alias %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
+
+func TestDiffAliasTargetSet(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]aliasTargetSetEntry{
+		"kept.example":    {destinationId: "ttcp_1"},
+		"moved.example":   {destinationId: "ttcp_1"},
+		"host.example":    {destinationId: "ttcp_1", hostId: "hst_1"},
+		"removed.example": {destinationId: "ttcp_1"},
+	}
+	desired := map[string]aliasTargetSetEntry{
+		"kept.example":  {destinationId: "ttcp_1"},
+		"moved.example": {destinationId: "ttcp_2"},
+		"host.example":  {destinationId: "ttcp_1"},
+		"added.example": {destinationId: "ttcp_1", hostId: "hst_2"},
+	}
+
+	create, update, remove := diffAliasTargetSet(current, desired)
+	if want := []string{"added.example"}; !reflect.DeepEqual(create, want) {
+		t.Errorf("got create %v, want %v", create, want)
+	}
+	if want := []string{"host.example", "moved.example"}; !reflect.DeepEqual(update, want) {
+		t.Errorf("got update %v, want %v", update, want)
+	}
+	if want := []string{"removed.example"}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("got remove %v, want %v", remove, want)
+	}
+
+	create, update, remove = diffAliasTargetSet(nil, desired)
+	if len(create) != len(desired) || len(update) != 0 || len(remove) != 0 {
+		t.Errorf("expected only creations from an empty set, got %v, %v and %v", create, update, remove)
+	}
+}
@@ -32,14 +32,44 @@ const (
 )
 
 var fooBarTarget = `
+resource "boundary_host_catalog_static" "alias" {
+	name       = "alias"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}
+
+resource "boundary_host_static" "alias_one" {
+	name            = "one"
+	host_catalog_id = boundary_host_catalog_static.alias.id
+	address         = "10.0.0.1"
+}
+
+resource "boundary_host_static" "alias_two" {
+	name            = "two"
+	host_catalog_id = boundary_host_catalog_static.alias.id
+	address         = "10.0.0.2"
+}
+
+resource "boundary_host_static" "alias_other" {
+	name            = "other"
+	host_catalog_id = boundary_host_catalog_static.alias.id
+	address         = "10.0.0.3"
+}
+
+resource "boundary_host_set_static" "alias" {
+	name            = "alias"
+	host_catalog_id = boundary_host_catalog_static.alias.id
+	host_ids        = [boundary_host_static.alias_one.id, boundary_host_static.alias_two.id]
+}
+
 resource "boundary_target" "foo" {
-	type         = "tcp"
-	name         = "test"
-	description  = "test target"
-	default_port = 22
-	address      = "127.0.0.1"
-	scope_id     = boundary_scope.proj1.id
-	depends_on   = [boundary_role.proj1_admin]
+	type            = "tcp"
+	name            = "test"
+	description     = "test target"
+	default_port    = 22
+	host_source_ids = [boundary_host_set_static.alias.id]
+	scope_id        = boundary_scope.proj1.id
+	depends_on      = [boundary_role.proj1_admin]
 }`
 
 var aliasId string
@@ -52,7 +82,7 @@ resource "boundary_alias_target" "example" {
 	value = "%s"
 	scope_id = "global"
 	destination_id = boundary_target.foo.id
-	authorize_session_host_id = "hst_1234567890"
+	authorize_session_host_id = boundary_host_static.alias_one.id
 	depends_on = [boundary_target.foo]
 }`, name, description, value)
 }
@@ -69,8 +99,8 @@ func TestAccAliasTarget(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAliasResourceDestroy(t, provider, targetAliasType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAliasResourceDestroy(t, provider, targetAliasType),
 		Steps: []resource.TestStep{
 			{
 				// create
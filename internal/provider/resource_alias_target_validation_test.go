@@ -3,7 +3,32 @@
 
 package provider
 
-import "testing"
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// testAliasTargetSetDiff is an aliasTargetSetDiffGetter backed by a map, keys in unknown are
+// treated as not yet known during plan
+type testAliasTargetSetDiff struct {
+	values  map[string]interface{}
+	unknown map[string]bool
+}
+
+func (d testAliasTargetSetDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	return v, ok
+}
+
+func (d testAliasTargetSetDiff) NewValueKnown(key string) bool {
+	return !d.unknown[key]
+}
 
 func TestValidateTargetAliasScope(t *testing.T) {
 	t.Parallel()
@@ -50,3 +75,190 @@ func TestValidateTargetAliasScope(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTargetAliasValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     string
+		wantError bool
+	}{
+		{name: "single label", value: "prod"},
+		{name: "dns name", value: "web-1.fleet.example"},
+		{name: "digits", value: "10-0-0-1.example"},
+		{name: "empty", value: "", wantError: true},
+		{name: "leading dot", value: ".example", wantError: true},
+		{name: "trailing dot", value: "example.", wantError: true},
+		{name: "consecutive dots", value: "web..example", wantError: true},
+		{name: "leading hyphen", value: "-web.example", wantError: true},
+		{name: "trailing hyphen", value: "web-.example", wantError: true},
+		{name: "underscore", value: "web_1.example", wantError: true},
+		{name: "space", value: "web 1.example", wantError: true},
+		{name: "label too long", value: strings.Repeat("a", 64) + ".example", wantError: true},
+		{name: "value too long", value: strings.Repeat("a.", 127) + "a", wantError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTargetAliasValue(tt.value)
+			if tt.wantError && err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateAliasTargetSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		values    map[string]interface{}
+		unknown   map[string]bool
+		wantError bool
+	}{
+		{
+			name: "valid",
+			values: map[string]interface{}{
+				aliasTargetSetAliasesKey:                 map[string]interface{}{"one.example": "ttcp_1234567890"},
+				aliasTargetSetAuthorizeSessionHostIdsKey: map[string]interface{}{"one.example": "hst_1234567890"},
+			},
+		},
+		{
+			name: "invalid value",
+			values: map[string]interface{}{
+				aliasTargetSetAliasesKey: map[string]interface{}{"one..example": "ttcp_1234567890"},
+			},
+			wantError: true,
+		},
+		{
+			name: "host ID of unknown alias",
+			values: map[string]interface{}{
+				aliasTargetSetAliasesKey:                 map[string]interface{}{"one.example": "ttcp_1234567890"},
+				aliasTargetSetAuthorizeSessionHostIdsKey: map[string]interface{}{"two.example": "hst_1234567890"},
+			},
+			wantError: true,
+		},
+		{
+			name: "aliases not known yet",
+			values: map[string]interface{}{
+				aliasTargetSetAuthorizeSessionHostIdsKey: map[string]interface{}{"two.example": "hst_1234567890"},
+			},
+			unknown: map[string]bool{aliasTargetSetAliasesKey: true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAliasTargetSet(testAliasTargetSetDiff{values: tt.values, unknown: tt.unknown})
+			if tt.wantError && err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// testAliasController fakes the aliases, targets and host sets API of a
+// controller and counts the requests made to it
+type testAliasController struct {
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (c *testAliasController) metaData(t *testing.T) *metaData {
+	t.Helper()
+	c.requests = make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mu.Lock()
+		c.requests[r.URL.Path]++
+		c.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/aliases":
+			_, _ = w.Write([]byte(`{"items":[` +
+				`{"id":"alt_1111111111","value":"taken.example","type":"target"},` +
+				`{"id":"alt_2222222222","value":"own.example","type":"target"}` +
+				`],"response_type":"complete"}`))
+		case "/v1/targets/ttcp_1234567890":
+			_, _ = w.Write([]byte(`{"id":"ttcp_1234567890","type":"tcp","host_source_ids":["hsst_1234567890"]}`))
+		case "/v1/host-sets/hsst_1234567890":
+			_, _ = w.Write([]byte(`{"id":"hsst_1234567890","type":"static","host_ids":["hst_1234567890"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"kind":"NotFound","message":"Resource not found."}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetAddr(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	client.SetMaxRetries(0)
+	return &metaData{client: client}
+}
+
+func TestCheckTargetAliasCollision(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := &testAliasController{}
+	md := c.metaData(t)
+
+	values := []string{"one.example", "two.example", "own.example"}
+	if err := checkTargetAliasCollision(ctx, md, values, "alt_2222222222"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := checkTargetAliasCollision(ctx, md, append(values, "taken.example"), "alt_2222222222")
+	if err == nil || !strings.Contains(err.Error(), "alt_1111111111") {
+		t.Fatalf("expected a collision with alt_1111111111, got %v", err)
+	}
+	if got := c.requests["/v1/aliases"]; got != 2 {
+		t.Fatalf("expected the aliases to be listed once per check, got %d lists", got)
+	}
+}
+
+func TestTargetAliasHostChecker(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := &testAliasController{}
+	hosts := newTargetAliasHostChecker(c.metaData(t))
+
+	for i := 0; i < 100; i++ {
+		if err := hosts.check(ctx, "ttcp_1234567890", "hst_1234567890"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := hosts.check(ctx, "ttcp_1234567890", "hst_0987654321"); err == nil {
+		t.Fatal("expected an error for a host outside the host sets of the target")
+	}
+	if err := hosts.check(ctx, "ttcp_0987654321", "hst_1234567890"); err == nil {
+		t.Fatal("expected an error for a missing target")
+	}
+	for path, want := range map[string]int{
+		"/v1/targets/ttcp_1234567890":   1,
+		"/v1/host-sets/hsst_1234567890": 1,
+	} {
+		if got := c.requests[path]; got != want {
+			t.Fatalf("expected %d request(s) to %s, got %d", want, path, got)
+		}
+	}
+}
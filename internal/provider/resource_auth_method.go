@@ -278,7 +278,9 @@ func resourceAuthMethodUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if len(opts) > 0 {
 		opts = append(opts, authmethods.WithAutomaticVersioning(true))
-		amu, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		amu, err := retryOnVersionConflict(ctx, func() (*authmethods.AuthMethodUpdateResult, error) {
+			return amClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
@@ -303,7 +305,7 @@ func resourceAuthMethodDelete(ctx context.Context, d *schema.ResourceData, meta
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting auth method: %v", err)
 	}
 
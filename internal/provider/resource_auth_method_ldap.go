@@ -835,7 +835,9 @@ func resourceAuthMethodLdapUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if len(opts) > 0 {
 		opts = append(opts, authmethods.WithAutomaticVersioning(true))
-		amur, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		amur, err := retryOnVersionConflict(ctx, func() (*authmethods.AuthMethodUpdateResult, error) {
+			return amClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
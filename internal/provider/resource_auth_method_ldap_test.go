@@ -139,8 +139,8 @@ func TestAccAuthMethodLdap(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAuthMethodResourceDestroy(t, provider, ldapAuthMethodType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAuthMethodResourceDestroy(t, provider, ldapAuthMethodType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -407,7 +407,9 @@ func updateScopeWithPrimaryAuthMethodId(ctx context.Context, scopeId, authmethod
 	opts = append(opts, scopes.WithAutomaticVersioning(true))
 	opts = append(opts, scopes.WithPrimaryAuthMethodId(authmethodId))
 
-	_, err := scp.Update(ctx, scopeId, 0, opts...)
+	_, err := retryOnVersionConflict(ctx, func() (*scopes.ScopeUpdateResult, error) {
+		return scp.Update(ctx, scopeId, 0, opts...)
+	})
 	if err != nil {
 		return diag.Errorf("error updating scope: %v", err)
 	}
@@ -570,7 +572,9 @@ func resourceAuthMethodOidcUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if len(opts) > 0 {
 		opts = append(opts, authmethods.WithAutomaticVersioning(true))
-		amur, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		amur, err := retryOnVersionConflict(ctx, func() (*authmethods.AuthMethodUpdateResult, error) {
+			return amClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
@@ -602,7 +606,7 @@ func resourceAuthMethodOidcDelete(ctx context.Context, d *schema.ResourceData, m
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting auth method: %v", err)
 	}
 
@@ -110,8 +110,8 @@ func TestAccAuthMethodOidc(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckAuthMethodResourceDestroy(t, provider, oidcAuthMethodType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckAuthMethodResourceDestroy(t, provider, oidcAuthMethodType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -74,9 +74,10 @@ func resourceAuthMethodPassword() *schema.Resource {
 				Computed:    true,
 			},
 			authmethodIsPrimaryForScopeKey: {
-				Description: "When true, makes this auth method the primary auth method for the scope in which it resides.",
-				Type:        schema.TypeBool,
-				Optional:    true,
+				Description: "When true, makes this auth method the primary auth method for the scope in which it resides. " +
+					"Use `boundary_scope_primary_auth_method` instead when switching the primary auth method between auth methods.",
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 		},
 	}
@@ -270,7 +271,9 @@ func resourceAuthMethodPasswordUpdate(ctx context.Context, d *schema.ResourceDat
 
 	if len(opts) > 0 {
 		opts = append(opts, authmethods.WithAutomaticVersioning(true))
-		amur, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		amur, err := retryOnVersionConflict(ctx, func() (*authmethods.AuthMethodUpdateResult, error) {
+			return amClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
@@ -295,7 +298,7 @@ func resourceAuthMethodPasswordDelete(ctx context.Context, d *schema.ResourceDat
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting auth method: %v", err)
 	}
 
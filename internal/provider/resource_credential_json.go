@@ -196,7 +196,9 @@ func resourceCredentialJsonUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if len(opts) > 0 {
 		opts = append(opts, credentials.WithAutomaticVersioning(true))
-		credUpdate, err := client.Update(ctx, d.Id(), 0, opts...)
+		credUpdate, err := retryOnVersionConflict(ctx, func() (*credentials.CredentialUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential: %v", err)
 		}
@@ -217,7 +219,7 @@ func resourceCredentialJsonDelete(ctx context.Context, d *schema.ResourceData, m
 	client := credentials.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential: %v", err)
 	}
 
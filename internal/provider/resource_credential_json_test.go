@@ -69,8 +69,8 @@ func TestAccCredentialJson(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, jsonCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, jsonCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -5,12 +5,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -21,8 +25,39 @@ const (
 	credentialLibraryVaultPathKey                  = "path"
 	credentialLibraryCredentialTypeKey             = "credential_type"
 	credentialLibraryCredentialMappingOverridesKey = "credential_mapping_overrides"
+	credentialLibraryVaultKvV2Key                  = "kv_v2"
+	credentialLibraryVaultKvV2MountKey             = "mount"
+	credentialLibraryVaultKvV2SecretPathKey        = "secret_path"
+
+	mappingOverrideUsernameAttribute             = "username_attribute"
+	mappingOverridePasswordAttribute             = "password_attribute"
+	mappingOverrideDomainAttribute               = "domain_attribute"
+	mappingOverridePrivateKeyAttribute           = "private_key_attribute"
+	mappingOverridePrivateKeyPassphraseAttribute = "private_key_passphrase_attribute"
 )
 
+// credentialMappingOverrideKeys lists the credential_mapping_overrides keys
+// Boundary accepts for each credential type.
+var credentialMappingOverrideKeys = map[string][]string{
+	credentialUsernamePasswordCredentialType: {
+		mappingOverrideUsernameAttribute,
+		mappingOverridePasswordAttribute,
+	},
+	credentialUsernamePasswordDomainCredentialType: {
+		mappingOverrideUsernameAttribute,
+		mappingOverridePasswordAttribute,
+		mappingOverrideDomainAttribute,
+	},
+	credentialSshPrivateKeyCredentialType: {
+		mappingOverrideUsernameAttribute,
+		mappingOverridePrivateKeyAttribute,
+		mappingOverridePrivateKeyPassphraseAttribute,
+	},
+	credentialPasswordCredentialType: {
+		mappingOverridePasswordAttribute,
+	},
+}
+
 var libraryVaultAttrs = []string{
 	credentialLibraryVaultHttpMethodKey,
 	credentialLibraryVaultHttpRequestBodyKey,
@@ -64,35 +99,184 @@ func resourceCredentialLibraryVault() *schema.Resource {
 				ForceNew:    true,
 			},
 			credentialLibraryVaultHttpMethodKey: {
-				Description: "The HTTP method the library uses when requesting credentials from Vault. Defaults to 'GET'",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:   "The HTTP method the library uses when requesting credentials from Vault. Defaults to 'GET'",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{credentialLibraryVaultKvV2Key},
 			},
 			credentialLibraryVaultHttpRequestBodyKey: {
-				Description: "The body of the HTTP request the library sends to Vault when requesting credentials. Only valid if `http_method` is set to `POST`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:   "The body of the HTTP request the library sends to Vault when requesting credentials. Only valid if `http_method` is set to `POST`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{credentialLibraryVaultKvV2Key},
 			},
 			credentialLibraryVaultPathKey: {
-				Description: "The path in Vault to request credentials from.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The path in Vault to request credentials from. This or `kv_v2` must be defined.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{credentialLibraryVaultPathKey, credentialLibraryVaultKvV2Key},
+			},
+			credentialLibraryVaultKvV2Key: {
+				Description: "Reads credentials from a secret in a KV version 2 secrets engine. The library path is built from " +
+					"the mount and secret path. This or `path` must be defined. Boundary reads the credential fields from the " +
+					"`data` object KV version 2 nests the secret in, so `credential_mapping_overrides` name the keys of the " +
+					"secret as written to Vault and none are needed for the default `username`, `password`, `domain`, " +
+					"`private_key` and `private_key_passphrase` keys.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{credentialLibraryVaultPathKey, credentialLibraryVaultKvV2Key},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						credentialLibraryVaultKvV2MountKey: {
+							Description: "The path the KV version 2 secrets engine is mounted at, e.g. `secret`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						credentialLibraryVaultKvV2SecretPathKey: {
+							Description: "The path of the secret within the secrets engine, e.g. `app/db`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
 			},
 			credentialLibraryCredentialTypeKey: {
-				Description: "The type of credential the library generates. Cannot be updated on an existing resource.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
+				Description: "The type of credential the library generates. Cannot be updated on an existing resource. " +
+					"One of `username_password`, `username_password_domain`, `ssh_private_key` or `password`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(credentialLibraryVaultCredentialTypes(), false),
 			},
 			credentialLibraryCredentialMappingOverridesKey: {
-				Description: "The credential mapping override.",
-				Type:        schema.TypeMap,
-				Optional:    true,
+				Description: "The credential mapping override. Requires `credential_type`. Valid keys are `username_attribute` and " +
+					"`password_attribute` for `username_password`; `username_attribute`, `password_attribute` and `domain_attribute` " +
+					"for `username_password_domain`; `username_attribute`, `private_key_attribute` and `private_key_passphrase_attribute` " +
+					"for `ssh_private_key`; and `password_attribute` for `password`.",
+				Type:     schema.TypeMap,
+				Optional: true,
 			},
 		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if d.NewValueKnown(credentialLibraryCredentialTypeKey) && d.NewValueKnown(credentialLibraryCredentialMappingOverridesKey) {
+				overrides, _ := d.Get(credentialLibraryCredentialMappingOverridesKey).(map[string]interface{})
+				if err := validateCredentialMappingOverrides(d.Get(credentialLibraryCredentialTypeKey).(string), overrides); err != nil {
+					return err
+				}
+			}
+			if d.NewValueKnown(credentialLibraryVaultHttpMethodKey) && d.NewValueKnown(credentialLibraryVaultHttpRequestBodyKey) {
+				if err := validateVaultHttpRequestBody(d.Get(credentialLibraryVaultHttpMethodKey).(string), d.Get(credentialLibraryVaultHttpRequestBodyKey).(string)); err != nil {
+					return err
+				}
+			}
+			if mount, secretPath, ok := vaultKvV2Block(d); ok {
+				if !d.NewValueKnown(credentialLibraryVaultKvV2Key+".0."+credentialLibraryVaultKvV2MountKey) ||
+					!d.NewValueKnown(credentialLibraryVaultKvV2Key+".0."+credentialLibraryVaultKvV2SecretPathKey) {
+					return d.SetNewComputed(credentialLibraryVaultPathKey)
+				}
+				path, err := vaultKvV2Path(mount, secretPath)
+				if err != nil {
+					return err
+				}
+				if path != d.Get(credentialLibraryVaultPathKey).(string) {
+					return d.SetNew(credentialLibraryVaultPathKey, path)
+				}
+			}
+			return nil
+		},
 	}
 }
 
+// credentialLibraryVaultCredentialTypes returns the credential types a
+// generic Vault credential library can issue, sorted.
+func credentialLibraryVaultCredentialTypes() []string {
+	types := make([]string, 0, len(credentialMappingOverrideKeys))
+	for t := range credentialMappingOverrideKeys {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// validateCredentialMappingOverrides checks the override keys against the
+// ones Boundary accepts for the credential type.
+func validateCredentialMappingOverrides(credentialType string, overrides map[string]interface{}) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	if credentialType == "" {
+		return fmt.Errorf("%s requires %s to be set", credentialLibraryCredentialMappingOverridesKey, credentialLibraryCredentialTypeKey)
+	}
+	valid, ok := credentialMappingOverrideKeys[credentialType]
+	if !ok {
+		return fmt.Errorf("%s are not supported for credential type %q", credentialLibraryCredentialMappingOverridesKey, credentialType)
+	}
+
+	var invalid []string
+	for k := range overrides {
+		found := false
+		for _, v := range valid {
+			if k == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			invalid = append(invalid, k)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("invalid %s for credential type %q: %s; valid keys are %s",
+			credentialLibraryCredentialMappingOverridesKey, credentialType, strings.Join(invalid, ", "), strings.Join(valid, ", "))
+	}
+
+	return nil
+}
+
+// validateVaultHttpRequestBody ensures a request body is only sent with POST
+// requests.
+func validateVaultHttpRequestBody(httpMethod, httpRequestBody string) error {
+	if httpRequestBody != "" && !strings.EqualFold(httpMethod, http.MethodPost) {
+		return fmt.Errorf("%s can only be set when %s is %q", credentialLibraryVaultHttpRequestBodyKey, credentialLibraryVaultHttpMethodKey, http.MethodPost)
+	}
+	return nil
+}
+
+// vaultKvV2Block returns the mount and secret path from the kv_v2 block, if
+// it is set.
+func vaultKvV2Block(d resourceDataGetter) (string, string, bool) {
+	raw, ok := d.GetOk(credentialLibraryVaultKvV2Key)
+	if !ok {
+		return "", "", false
+	}
+	blocks := raw.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return "", "", false
+	}
+	block := blocks[0].(map[string]interface{})
+	return block[credentialLibraryVaultKvV2MountKey].(string), block[credentialLibraryVaultKvV2SecretPathKey].(string), true
+}
+
+// vaultKvV2Path builds the API path used to read a secret from a KV version 2
+// secrets engine, which nests secrets under "data/". No mapping overrides are
+// derived from the block: Boundary unwraps the data and metadata envelope of
+// KV version 2 responses before looking up the credential fields.
+func vaultKvV2Path(mount, secretPath string) (string, error) {
+	mount = strings.Trim(mount, "/")
+	secretPath = strings.Trim(secretPath, "/")
+	if mount == "" {
+		return "", fmt.Errorf("%s.%s must not be empty", credentialLibraryVaultKvV2Key, credentialLibraryVaultKvV2MountKey)
+	}
+	if secretPath == "" {
+		return "", fmt.Errorf("%s.%s must not be empty", credentialLibraryVaultKvV2Key, credentialLibraryVaultKvV2SecretPathKey)
+	}
+	return mount + "/data/" + secretPath, nil
+}
+
 func setFromVaultCredentialLibraryResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
 	if err := d.Set(NameKey, raw[NameKey]); err != nil {
 		return err
@@ -138,7 +322,13 @@ func resourceCredentialLibraryCreateVault(ctx context.Context, d *schema.Resourc
 	if v, ok := d.GetOk(credentialLibraryVaultHttpRequestBodyKey); ok {
 		opts = append(opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(v.(string)))
 	}
-	if v, ok := d.GetOk(credentialLibraryVaultPathKey); ok {
+	if mount, secretPath, ok := vaultKvV2Block(d); ok {
+		path, err := vaultKvV2Path(mount, secretPath)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts = append(opts, credentiallibraries.WithVaultCredentialLibraryPath(path))
+	} else if v, ok := d.GetOk(credentialLibraryVaultPathKey); ok {
 		opts = append(opts, credentiallibraries.WithVaultCredentialLibraryPath(v.(string)))
 	}
 	if v, ok := d.GetOk(credentialLibraryCredentialTypeKey); ok {
@@ -229,9 +419,14 @@ func resourceCredentialLibraryUpdateVault(ctx context.Context, d *schema.Resourc
 			opts = append(opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(v.(string)))
 		}
 	}
-	if d.HasChange(credentialLibraryVaultPathKey) {
-		v, ok := d.GetOk(credentialLibraryVaultPathKey)
-		if ok {
+	if d.HasChanges(credentialLibraryVaultPathKey, credentialLibraryVaultKvV2Key) {
+		if mount, secretPath, ok := vaultKvV2Block(d); ok {
+			path, err := vaultKvV2Path(mount, secretPath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			opts = append(opts, credentiallibraries.WithVaultCredentialLibraryPath(path))
+		} else if v, ok := d.GetOk(credentialLibraryVaultPathKey); ok {
 			opts = append(opts, credentiallibraries.WithVaultCredentialLibraryPath(v.(string)))
 		}
 	}
@@ -272,7 +467,9 @@ func resourceCredentialLibraryUpdateVault(ctx context.Context, d *schema.Resourc
 
 	if len(opts) > 0 {
 		opts = append(opts, credentiallibraries.WithAutomaticVersioning(true))
-		aur, err := client.Update(ctx, d.Id(), 0, opts...)
+		aur, err := retryOnVersionConflict(ctx, func() (*credentiallibraries.CredentialLibraryUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential library: %v", err)
 		}
@@ -290,7 +487,7 @@ func resourceCredentialLibraryDeleteVault(ctx context.Context, d *schema.Resourc
 	client := credentiallibraries.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential library: %v", err)
 	}
 
@@ -178,7 +178,9 @@ func resourceCredentialLibraryUpdateVaultLdap(ctx context.Context, d *schema.Res
 	}
 	if len(opts) > 0 {
 		opts = append(opts, credentiallibraries.WithAutomaticVersioning(true))
-		aur, err := client.Update(ctx, d.Id(), 0, opts...)
+		aur, err := retryOnVersionConflict(ctx, func() (*credentiallibraries.CredentialLibraryUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential library: %v", err)
 		}
@@ -196,7 +198,7 @@ func resourceCredentialLibraryDeleteVaultLdap(ctx context.Context, d *schema.Res
 	client := credentiallibraries.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential library: %v", err)
 	}
 
@@ -406,7 +406,9 @@ func resourceCredentialLibraryUpdateVaultSshCertificate(ctx context.Context, d *
 
 	if len(opts) > 0 {
 		opts = append(opts, credentiallibraries.WithAutomaticVersioning(true))
-		aur, err := client.Update(ctx, d.Id(), 0, opts...)
+		aur, err := retryOnVersionConflict(ctx, func() (*credentiallibraries.CredentialLibraryUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential library: %v", err)
 		}
@@ -424,7 +426,7 @@ func resourceCredentialLibraryDeleteVaultSshCertificate(ctx context.Context, d *
 	client := credentiallibraries.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential library: %v", err)
 	}
 
@@ -23,6 +23,7 @@ const (
 	vaultCredTypedResc            = "boundary_credential_library_vault.typed_example"
 	vaultCredUsernamePasswordResc = "boundary_credential_library_vault.username_password_mapping_override"
 	vaultCredSshPrivateKeyResc    = "boundary_credential_library_vault.ssh_private_key_mapping_override"
+	vaultCredKvV2Resc             = "boundary_credential_library_vault.kv_v2"
 	vaultCredLibName              = "foo"
 	vaultCredLibNameOverride      = "base foo"
 	vaultCredLibDesc              = "the foo"
@@ -137,6 +138,35 @@ resource "boundary_credential_library_vault" "ssh_private_key_mapping_override"
 	vaultCredLibPath,
 	vaultCredLibMethodGet)
 
+var vaultKvV2CredLibResource = fmt.Sprintf(`
+resource "boundary_credential_library_vault" "kv_v2" {
+	name                = "%s"
+	description         = "%s"
+	credential_store_id = boundary_credential_store_vault.example.id
+	credential_type     = "username_password"
+	kv_v2 {
+		mount       = "secret"
+		secret_path = "app/db"
+	}
+}`, vaultCredLibName,
+	vaultCredLibDesc)
+
+var vaultKvV2CredLibResourceOverride = fmt.Sprintf(`
+resource "boundary_credential_library_vault" "kv_v2" {
+	name                = "%s"
+	description         = "%s"
+	credential_store_id = boundary_credential_store_vault.example.id
+	credential_type     = "username_password"
+	kv_v2 {
+		mount       = "secret"
+		secret_path = "app/db"
+	}
+	credential_mapping_overrides = {
+		username_attribute = "user"
+	}
+}`, vaultCredLibName,
+	vaultCredLibDesc)
+
 func TestAccCredentialLibraryVault(t *testing.T) {
 	tc := controller.NewTestController(t, tcConfig...)
 	defer tc.Shutdown()
@@ -154,9 +184,9 @@ func TestAccCredentialLibraryVault(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialLibraryVaultResourceDestroy(t, provider, baseVaultCredentialLibraryType),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialLibraryVaultResourceDestroy(t, provider, baseVaultCredentialLibraryType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -297,6 +327,55 @@ func testAccCheckCredentialLibraryResourceExists(testProvider *schema.Provider,
 	}
 }
 
+func TestAccCredentialLibraryVaultKvV2(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialLibraryVaultResourceDestroy(t, provider, baseVaultCredentialLibraryType),
+		Steps: []resource.TestStep{
+			{
+				// create, Boundary unwraps the KV v2 data so no override is sent
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultKvV2CredLibResource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultCredKvV2Resc, credentialLibraryVaultPathKey, "secret/data/app/db"),
+					resource.TestCheckResourceAttr(vaultCredKvV2Resc, credentialLibraryCredentialTypeKey, "username_password"),
+					resource.TestCheckResourceAttr(vaultCredKvV2Resc, credentialLibraryCredentialMappingOverridesKey+".%", "0"),
+
+					testAccCheckCredentialLibraryResourceExists(provider, vaultCredKvV2Resc),
+				),
+			},
+			importStep(vaultCredKvV2Resc, credentialLibraryVaultKvV2Key),
+			{
+				// overrides name the keys of the secret, not of the KV v2 envelope
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultKvV2CredLibResourceOverride),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultCredKvV2Resc, credentialLibraryVaultPathKey, "secret/data/app/db"),
+					resource.TestCheckResourceAttr(vaultCredKvV2Resc, credentialLibraryCredentialMappingOverridesKey+".%", "1"),
+					resource.TestCheckResourceAttr(vaultCredKvV2Resc, credentialLibraryCredentialMappingOverridesKey+"."+mappingOverrideUsernameAttribute, "user"),
+
+					testAccCheckCredentialLibraryResourceExists(provider, vaultCredKvV2Resc),
+				),
+			},
+			importStep(vaultCredKvV2Resc, credentialLibraryVaultKvV2Key),
+		},
+	})
+}
+
 type vaultCredentialLibraryType string
 
 const (
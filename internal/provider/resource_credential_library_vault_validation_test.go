@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestValidateCredentialMappingOverrides(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		credentialType string
+		overrides      map[string]interface{}
+		wantError      bool
+	}{
+		{
+			name:           "no overrides",
+			credentialType: "",
+		},
+		{
+			name:           "username password",
+			credentialType: credentialUsernamePasswordCredentialType,
+			overrides: map[string]interface{}{
+				"username_attribute": "user",
+				"password_attribute": "pass",
+			},
+		},
+		{
+			name:           "username password domain",
+			credentialType: credentialUsernamePasswordDomainCredentialType,
+			overrides: map[string]interface{}{
+				"domain_attribute": "realm",
+			},
+		},
+		{
+			name:           "ssh private key",
+			credentialType: credentialSshPrivateKeyCredentialType,
+			overrides: map[string]interface{}{
+				"private_key_attribute":            "key",
+				"private_key_passphrase_attribute": "passphrase",
+			},
+		},
+		{
+			name:           "password",
+			credentialType: credentialPasswordCredentialType,
+			overrides: map[string]interface{}{
+				"password_attribute": "pass",
+			},
+		},
+		{
+			name:           "misspelled key",
+			credentialType: credentialUsernamePasswordCredentialType,
+			overrides: map[string]interface{}{
+				"password_attr": "pass",
+			},
+			wantError: true,
+		},
+		{
+			name:           "key of another credential type",
+			credentialType: credentialUsernamePasswordCredentialType,
+			overrides: map[string]interface{}{
+				"private_key_attribute": "key",
+			},
+			wantError: true,
+		},
+		{
+			name:           "domain for password",
+			credentialType: credentialPasswordCredentialType,
+			overrides: map[string]interface{}{
+				"domain_attribute": "realm",
+			},
+			wantError: true,
+		},
+		{
+			name: "overrides without credential type",
+			overrides: map[string]interface{}{
+				"username_attribute": "user",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateCredentialMappingOverrides(tt.credentialType, tt.overrides)
+			if tt.wantError && err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateVaultHttpRequestBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		method    string
+		body      string
+		wantError bool
+	}{
+		{name: "no body", method: ""},
+		{name: "body with post", method: "POST", body: `{"common_name":"boundary"}`},
+		{name: "body with lowercase post", method: "post", body: `{"common_name":"boundary"}`},
+		{name: "body with default method", method: "", body: `{"common_name":"boundary"}`, wantError: true},
+		{name: "body with get", method: "GET", body: `{"common_name":"boundary"}`, wantError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateVaultHttpRequestBody(tt.method, tt.body)
+			if tt.wantError && err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVaultKvV2Path(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		mount      string
+		secretPath string
+		want       string
+		wantError  bool
+	}{
+		{name: "simple", mount: "secret", secretPath: "app/db", want: "secret/data/app/db"},
+		{name: "slashes trimmed", mount: "/secret/", secretPath: "/app/db/", want: "secret/data/app/db"},
+		{name: "nested mount", mount: "team/kv", secretPath: "db", want: "team/kv/data/db"},
+		{name: "empty mount", mount: "", secretPath: "db", wantError: true},
+		{name: "empty secret path", mount: "secret", secretPath: "/", wantError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := vaultKvV2Path(tt.mount, tt.secretPath)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -193,7 +193,9 @@ func resourceCredentialPasswordUpdate(ctx context.Context, d *schema.ResourceDat
 
 	if len(opts) > 0 {
 		opts = append(opts, credentials.WithAutomaticVersioning(true))
-		crUpdate, err := client.Update(ctx, d.Id(), 0, opts...)
+		crUpdate, err := retryOnVersionConflict(ctx, func() (*credentials.CredentialUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential: %v", err)
 		}
@@ -214,7 +216,7 @@ func resourceCredentialPasswordDelete(ctx context.Context, d *schema.ResourceDat
 	client := credentials.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential: %v", err)
 	}
 
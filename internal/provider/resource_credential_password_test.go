@@ -61,8 +61,8 @@ func TestAccCredentialPassword(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, passwordCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, passwordCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -69,9 +69,9 @@ func TestAccCredentialSshPrivateKey(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, sshPrivateKeyCredentialType),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, sshPrivateKeyCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
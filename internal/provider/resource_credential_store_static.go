@@ -150,7 +150,9 @@ func resourceStaticCredentialStoreUpdate(ctx context.Context, d *schema.Resource
 
 	if len(opts) > 0 {
 		opts = append(opts, credentialstores.WithAutomaticVersioning(true))
-		crUpdate, err := client.Update(ctx, d.Id(), 0, opts...)
+		crUpdate, err := retryOnVersionConflict(ctx, func() (*credentialstores.CredentialStoreUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential store: %v", err)
 		}
@@ -171,7 +173,7 @@ func resourceStaticCredentialStoreDelete(ctx context.Context, d *schema.Resource
 	client := credentialstores.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential store: %v", err)
 	}
 
@@ -46,8 +46,8 @@ func TestAccCredentialStoreStatic(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialStoreResourceDestroy(t, provider, staticStoreCredentialStoreType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialStoreResourceDestroy(t, provider, staticStoreCredentialStoreType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,6 +23,9 @@ const (
 	credentialStoreVaultTlsSkipVerifyKey            = "tls_skip_verify"
 	credentialStoreVaultTokenKey                    = "token"
 	credentialStoreVaultTokenHmacKey                = "token_hmac"
+	credentialStoreVaultTokenWoKey                  = "token_wo"
+	credentialStoreVaultTokenWoVersionKey           = "token_wo_version"
+	credentialStoreVaultTokenStatusKey              = "token_status"
 	credentialStoreVaultClientCertificateKey        = "client_certificate"
 	credentialStoreVaultClientCertificateKeyKey     = "client_certificate_key"
 	credentialStoreVaultClientCertificateKeyHmacKey = "client_certificate_key_hmac"
@@ -99,16 +103,39 @@ func resourceCredentialStoreVault() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreVaultTokenKey: {
-				Description: "A token used for accessing Vault.",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:  "A token used for accessing Vault. This or " + credentialStoreVaultTokenWoKey + " must be defined.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{credentialStoreVaultTokenKey, credentialStoreVaultTokenWoKey},
+			},
+			credentialStoreVaultTokenWoKey: {
+				Description: "A token used for accessing Vault, as a write-only argument that is never stored in state. " +
+					"Accepts ephemeral values, e.g. a token from the Vault provider. " +
+					"Increment " + credentialStoreVaultTokenWoVersionKey + " to send a new token to Boundary. Requires Terraform 1.11+.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{credentialStoreVaultTokenKey, credentialStoreVaultTokenWoKey},
+				RequiredWith: []string{credentialStoreVaultTokenWoVersionKey},
+			},
+			credentialStoreVaultTokenWoVersionKey: {
+				Description:  "The version of " + credentialStoreVaultTokenWoKey + ". Changing this value sends the current value of " + credentialStoreVaultTokenWoKey + " to Boundary.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{credentialStoreVaultTokenWoKey},
 			},
 			credentialStoreVaultTokenHmacKey: {
 				Description: "The Vault token hmac.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			credentialStoreVaultTokenStatusKey: {
+				Description: "The status of the Vault token as reported by Boundary, e.g. `current` or `expired`. Boundary does not report when the token expires, refresh warns once it reports the token as expired or revoked.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			credentialStoreVaultClientCertificateKey: {
 				Description: "A PEM-encoded client certificate to use for TLS authentication to the Vault server.",
 				Type:        schema.TypeString,
@@ -156,27 +183,38 @@ func setFromVaultCredentialStoreResponseMap(d *schema.ResourceData, raw map[stri
 		}
 
 		boundaryTokenHmac, ok := attrs[credentialStoreVaultTokenHmacKey]
-		tokenStatus := attrs["token_status"]
-		switch {
-		case ok:
+		if ok {
 			boundaryTokenHmacStr := boundaryTokenHmac.(string)
 			stateTokenHmac := d.Get(credentialStoreVaultTokenHmacKey)
 			if stateTokenHmac.(string) != boundaryTokenHmacStr && fromRead {
-				// TokenHmac has changed in Boundary, therefore the token has changed.
-				// Update token value to force tf to attempt update.
-				if err := d.Set(credentialStoreVaultTokenKey, "(changed in Boundary)"); err != nil {
-					return diag.FromErr(err)
+				switch {
+				case d.Get(credentialStoreVaultTokenKey).(string) != "":
+					// TokenHmac has changed in Boundary, therefore the token has changed.
+					// Update token value to force tf to attempt update.
+					if err := d.Set(credentialStoreVaultTokenKey, "(changed in Boundary)"); err != nil {
+						return diag.FromErr(err)
+					}
+				default:
+					// A write-only token is not in state, so there is nothing
+					// to force an update with
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  fmt.Sprintf("Vault token has changed in Boundary for credential store %q.", csId),
+						Detail:   "Increment " + credentialStoreVaultTokenWoVersionKey + " to send the configured token to Boundary again.",
+					})
 				}
 			}
 			if err := d.Set(credentialStoreVaultTokenHmacKey, boundaryTokenHmacStr); err != nil {
 				return diag.FromErr(err)
 			}
+		}
 
-		case tokenStatus == "expired":
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("Vault token has expired for credential store %q, please update.", csId),
-			})
+		tokenStatus, _ := attrs[credentialStoreVaultTokenStatusKey].(string)
+		if err := d.Set(credentialStoreVaultTokenStatusKey, tokenStatus); err != nil {
+			return diag.FromErr(err)
+		}
+		if fromRead {
+			diags = append(diags, vaultTokenStatusDiagnostics(csId.(string), tokenStatus)...)
 		}
 
 		stateClientKeyHmac := d.Get(credentialStoreVaultClientCertificateKeyHmacKey)
@@ -235,6 +273,11 @@ func resourceCredentialStoreVaultCreate(ctx context.Context, d *schema.ResourceD
 	if v, ok := d.GetOk(credentialStoreVaultTokenKey); ok {
 		opts = append(opts, credentialstores.WithVaultCredentialStoreToken(v.(string)))
 	}
+	if token, diags := vaultCredentialStoreTokenWo(d); diags.HasError() {
+		return diags
+	} else if token != "" {
+		opts = append(opts, credentialstores.WithVaultCredentialStoreToken(token))
+	}
 	if v, ok := d.GetOk(credentialStoreVaultWorkerFilterKey); ok {
 		opts = append(opts, credentialstores.WithVaultCredentialStoreWorkerFilter(v.(string)))
 	}
@@ -353,6 +396,16 @@ func resourceCredentialStoreVaultUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange(credentialStoreVaultTokenWoVersionKey) {
+		token, diags := vaultCredentialStoreTokenWo(d)
+		if diags.HasError() {
+			return diags
+		}
+		if token != "" {
+			opts = append(opts, credentialstores.WithVaultCredentialStoreToken(token))
+		}
+	}
+
 	if d.HasChange(credentialStoreVaultClientCertificateKey) {
 		opts = append(opts, credentialstores.DefaultVaultCredentialStoreClientCertificate())
 		v, ok := d.GetOk(credentialStoreVaultClientCertificateKey)
@@ -379,7 +432,9 @@ func resourceCredentialStoreVaultUpdate(ctx context.Context, d *schema.ResourceD
 
 	if len(opts) > 0 {
 		opts = append(opts, credentialstores.WithAutomaticVersioning(true))
-		crUpdate, err := client.Update(ctx, d.Id(), 0, opts...)
+		crUpdate, err := retryOnVersionConflict(ctx, func() (*credentialstores.CredentialStoreUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential store: %v", err)
 		}
@@ -400,9 +455,37 @@ func resourceCredentialStoreVaultDelete(ctx context.Context, d *schema.ResourceD
 	client := credentialstores.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential store: %v", err)
 	}
 
 	return nil
 }
+
+// vaultCredentialStoreTokenWo returns the write-only token from config, or an
+// empty string if it is not set. Write-only values are only available from
+// the raw config and only during apply.
+func vaultCredentialStoreTokenWo(d *schema.ResourceData) (string, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(credentialStoreVaultTokenWoKey))
+	if diags.HasError() {
+		return "", diags
+	}
+	if !v.Type().Equals(cty.String) || v.IsNull() || !v.IsKnown() {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
+// vaultTokenStatusDiagnostics warns when Boundary reports the Vault token as
+// unusable
+func vaultTokenStatusDiagnostics(csId, tokenStatus string) diag.Diagnostics {
+	switch tokenStatus {
+	case "expired", "revoked":
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Vault token has %s for credential store %q, please update.", tokenStatus, csId),
+		}}
+	default:
+		return nil
+	}
+}
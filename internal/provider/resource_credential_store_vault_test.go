@@ -62,6 +62,28 @@ resource "boundary_credential_store_vault" "example" {
 		clientKey)
 }
 
+func vaultCredStoreTokenWoResource(vc *vault.TestVaultServer, token string, version int) string {
+	caCert := fmt.Sprintf("\"%s\"", strings.Replace(string(vc.CaCert), "\n", `\n`, -1))
+
+	return fmt.Sprintf(`
+resource "boundary_credential_store_vault" "example" {
+	name  = "%s"
+	description = "%s"
+	scope_id = boundary_scope.proj1.id
+	address = "%s"
+	ca_cert = %s
+	tls_skip_verify = true
+	token_wo = "%s"
+	token_wo_version = %d
+	depends_on  = [boundary_role.proj1_admin]
+}`, vaultCredStoreName,
+		vaultCredStoreDesc,
+		vc.Addr,
+		caCert,
+		token,
+		version)
+}
+
 func tokenHmac(token, accessor string) string {
 	key := blake2b.Sum256([]byte(accessor))
 	mac := hmac.New(sha256.New, key[:])
@@ -130,8 +152,8 @@ func TestAccCredentialStoreVault(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialStoreResourceDestroy(t, provider, vaultStoreCredentialStoreType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialStoreResourceDestroy(t, provider, vaultStoreCredentialStoreType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -193,6 +215,78 @@ func TestAccCredentialStoreVault(t *testing.T) {
 	})
 }
 
+// TestAccCredentialStoreVaultTokenWo requires Terraform 1.11+ for the
+// write-only token
+func TestAccCredentialStoreVaultTokenWo(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	secret, token := vc.CreateToken(t)
+	tHmac := tokenHmac(token, secret.Auth.Accessor)
+	res := vaultCredStoreTokenWoResource(vc, token, 1)
+
+	secret, tokenUpdate := vc.CreateToken(t)
+	tHmacUpdate := tokenHmac(tokenUpdate, secret.Auth.Accessor)
+	resUpdate := vaultCredStoreTokenWoResource(vc, tokenUpdate, 2)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialStoreResourceDestroy(t, provider, vaultStoreCredentialStoreType),
+		Steps: []resource.TestStep{
+			{
+				// create with a write-only token
+				Config: testConfig(url, fooOrg, firstProjectFoo, res),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultCredStoreResc, credentialStoreVaultTokenWoVersionKey, "1"),
+					resource.TestCheckResourceAttr(vaultCredStoreResc, credentialStoreVaultTokenHmacKey, tHmac),
+					resource.TestCheckResourceAttr(vaultCredStoreResc, credentialStoreVaultTokenStatusKey, "current"),
+					testAccCheckVaultCredentialStoreTokenNotInState(vaultCredStoreResc),
+
+					testAccCheckCredentialStoreResourceExists(provider, vaultCredStoreResc),
+				),
+			},
+			{
+				// The token is not sent again while its version is unchanged
+				PlanOnly: true,
+				Config:   testConfig(url, fooOrg, firstProjectFoo, res),
+			},
+			{
+				// rotate the token by bumping its version
+				Config: testConfig(url, fooOrg, firstProjectFoo, resUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultCredStoreResc, credentialStoreVaultTokenWoVersionKey, "2"),
+					resource.TestCheckResourceAttr(vaultCredStoreResc, credentialStoreVaultTokenHmacKey, tHmacUpdate),
+					resource.TestCheckResourceAttr(vaultCredStoreResc, credentialStoreVaultTokenStatusKey, "current"),
+					testAccCheckVaultCredentialStoreTokenNotInState(vaultCredStoreResc),
+
+					testAccCheckCredentialStoreResourceExists(provider, vaultCredStoreResc),
+				),
+			},
+			importStep(vaultCredStoreResc, credentialStoreVaultTokenWoVersionKey),
+		},
+	})
+}
+
+// testAccCheckVaultCredentialStoreTokenNotInState checks neither the token
+// nor the write-only token are stored in the state
+func testAccCheckVaultCredentialStoreTokenNotInState(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		for _, key := range []string{credentialStoreVaultTokenKey, credentialStoreVaultTokenWoKey} {
+			if v := rs.Primary.Attributes[key]; v != "" {
+				return fmt.Errorf("%s: expected %q not to be in state, got %q", name, key, v)
+			}
+		}
+		return nil
+	}
+}
+
 var storeId string
 
 // externalUpdate uses the global storeId, therefore this function cannot be called until
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestVaultTokenStatusDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		tokenStatus string
+		wantWarning bool
+	}{
+		{
+			name:        "current token",
+			tokenStatus: "current",
+		},
+		{
+			name:        "maintaining token",
+			tokenStatus: "maintaining",
+		},
+		{
+			name:        "expired token status",
+			tokenStatus: "expired",
+			wantWarning: true,
+		},
+		{
+			name:        "revoked token status",
+			tokenStatus: "revoked",
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := vaultTokenStatusDiagnostics("csvlt_1234567890", tt.tokenStatus)
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+			if tt.wantWarning && len(diags) == 0 {
+				t.Fatal("expected a warning but got none")
+			}
+			if !tt.wantWarning && len(diags) != 0 {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
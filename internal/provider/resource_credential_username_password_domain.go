@@ -274,7 +274,9 @@ func resourceCredentialUsernamePasswordDomainUpdate(ctx context.Context, d *sche
 
 	if len(opts) > 0 {
 		opts = append(opts, credentials.WithAutomaticVersioning(true))
-		crUpdate, err := client.Update(ctx, d.Id(), 0, opts...)
+		crUpdate, err := retryOnVersionConflict(ctx, func() (*credentials.CredentialUpdateResult, error) {
+			return client.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating credential: %v", err)
 		}
@@ -295,7 +297,7 @@ func resourceCredentialUsernamePasswordDomainDelete(ctx context.Context, d *sche
 	client := credentials.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting credential: %v", err)
 	}
 
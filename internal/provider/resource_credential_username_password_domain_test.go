@@ -108,8 +108,8 @@ func TestAccCredentialUsernamePasswordDomain(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -157,8 +157,8 @@ func TestAccCredentialUsernamePasswordDomain(t *testing.T) {
 	})
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -194,8 +194,8 @@ func TestAccCredentialUsernamePasswordDomain(t *testing.T) {
 	})
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -260,8 +260,8 @@ func TestAccCredentialUsernamePasswordDomain_DomainInUsernameFieldUsingAt(t *tes
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -353,8 +353,8 @@ func TestAccCredentialUsernamePasswordDomain_DomainInUsernameFieldUsingSlash(t *
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -562,8 +562,8 @@ func TestResourceCredentialUsernamePasswordDomainCustomizeDiff(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var provider *schema.Provider
 			tc := resource.TestCase{
-				ProviderFactories: providerFactories(&provider),
-				CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
+				ProtoV5ProviderFactories: providerFactories(&provider),
+				CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordDomainCredentialType),
 				Steps: []resource.TestStep{
 					{
 						// Create resource.
@@ -66,8 +66,8 @@ func TestAccCredentialUsernamePassword(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordCredentialType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckCredentialResourceDestroy(t, provider, usernamePasswordCredentialType),
 		Steps: []resource.TestStep{
 			{
 				// create
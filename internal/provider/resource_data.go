@@ -0,0 +1,11 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// resourceDataGetter is satisfied by both *schema.ResourceData and
+// *schema.ResourceDiff, so helpers reading the configuration can be used in
+// CRUD functions and in CustomizeDiff.
+type resourceDataGetter interface {
+	GetOk(string) (interface{}, bool)
+}
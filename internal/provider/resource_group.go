@@ -186,7 +186,9 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if len(opts) > 0 {
 		opts = append(opts, groups.WithAutomaticVersioning(true))
-		_, err := grps.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*groups.GroupUpdateResult, error) {
+			return grps.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating group: %v", err)
 		}
@@ -214,7 +216,9 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 
 		}
-		_, err := grps.SetMembers(ctx, d.Id(), 0, memberIds, groups.WithAutomaticVersioning(true))
+		_, err := retryOnVersionConflict(ctx, func() (*groups.GroupUpdateResult, error) {
+			return grps.SetMembers(ctx, d.Id(), 0, memberIds, groups.WithAutomaticVersioning(true))
+		})
 		if err != nil {
 			return diag.Errorf("error updating members in group: %v", err)
 		}
@@ -232,7 +236,7 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	grps := groups.NewClient(md.client)
 
 	_, err := grps.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error calling delete group: %s", err.Error())
 	}
 
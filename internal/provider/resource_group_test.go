@@ -125,9 +125,9 @@ func TestAccGroup(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckGroupResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckGroupResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -211,7 +211,7 @@ func TestAccGroupWithMembers(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
 
 		CheckDestroy: testAccCheckGroupResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
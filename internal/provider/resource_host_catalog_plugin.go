@@ -621,7 +621,9 @@ func resourceHostCatalogPluginUpdate(ctx context.Context, d *schema.ResourceData
 
 	if len(opts) > 0 {
 		opts = append(opts, hostcatalogs.WithAutomaticVersioning(true))
-		hcur, err := hcClient.Update(ctx, d.Id(), 0, opts...)
+		hcur, err := retryOnVersionConflict(ctx, func() (*hostcatalogs.HostCatalogUpdateResult, error) {
+			return hcClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return append(currentDiagnostics, diag.Errorf("error updating host catalog: %v", err)...)
 		}
@@ -667,7 +669,7 @@ func resourceHostCatalogPluginDelete(ctx context.Context, d *schema.ResourceData
 	hcClient := hostcatalogs.NewClient(md.client)
 
 	_, err := hcClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting host catalog: %v", err)
 	}
 
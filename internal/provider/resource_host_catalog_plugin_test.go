@@ -163,8 +163,8 @@ func TestAccPluginHostCatalog(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckHostCatalogResourceDestroy(t, provider, baseHostCatalogType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckHostCatalogResourceDestroy(t, provider, baseHostCatalogType),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -372,8 +372,8 @@ func TestAccPluginHostCatalogWithWorkerFilter(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckHostCatalogResourceDestroy(t, provider, baseHostCatalogType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckHostCatalogResourceDestroy(t, provider, baseHostCatalogType),
 		Steps: []resource.TestStep{
 			{
 				// test create
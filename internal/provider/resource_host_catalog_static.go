@@ -231,7 +231,9 @@ func resourceHostCatalogStaticUpdate(hasTypeKey bool) schema.UpdateContextFunc {
 
 		if len(opts) > 0 {
 			opts = append(opts, hostcatalogs.WithAutomaticVersioning(true))
-			hcrr, err := hcClient.Update(ctx, d.Id(), 0, opts...)
+			hcrr, err := retryOnVersionConflict(ctx, func() (*hostcatalogs.HostCatalogUpdateResult, error) {
+				return hcClient.Update(ctx, d.Id(), 0, opts...)
+			})
 			if err != nil {
 				return diag.Errorf("error updating host catalog: %v", err)
 			}
@@ -252,7 +254,7 @@ func resourceHostCatalogStaticDelete(ctx context.Context, d *schema.ResourceData
 	hcClient := hostcatalogs.NewClient(md.client)
 
 	_, err := hcClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting host catalog: %v", err)
 	}
 
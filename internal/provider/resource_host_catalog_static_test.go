@@ -69,8 +69,8 @@ func testAccHostCatalog(t *testing.T, static bool) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckHostCatalogResourceDestroy(t, provider, staticHostCatalogType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckHostCatalogResourceDestroy(t, provider, staticHostCatalogType),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -103,6 +103,7 @@ func resourceHostSetPlugin() *schema.Resource {
 					}
 				},
 			},
+			hostSetWaitForSyncKey: hostSetWaitForSyncSchema(),
 		},
 	}
 }
@@ -233,6 +234,16 @@ func resourceHostSetPluginCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	// The host set exists now, failing would taint it so not having synced
+	// yet is only a warning
+	if err := waitForHostSetSync(ctx, md, d); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Host set created before its hosts were synced",
+			Detail:   err.Error(),
+		}}
+	}
+
 	return nil
 }
 
@@ -331,7 +342,9 @@ func resourceHostSetPluginUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if len(opts) > 0 {
 		opts = append(opts, hostsets.WithAutomaticVersioning(true))
-		hsrr, err := hsClient.Update(ctx, d.Id(), 0, opts...)
+		hsrr, err := retryOnVersionConflict(ctx, func() (*hostsets.HostSetUpdateResult, error) {
+			return hsClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating host set: %v", err)
 		}
@@ -343,6 +356,12 @@ func resourceHostSetPluginUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChanges(hostSetSyncKeys...) {
+		if err := waitForHostSetSync(ctx, md, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -351,7 +370,7 @@ func resourceHostSetPluginDelete(ctx context.Context, d *schema.ResourceData, me
 	hsClient := hostsets.NewClient(md.client)
 
 	_, err := hsClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting host set: %s", err.Error())
 	}
 
@@ -86,8 +86,8 @@ func TestAccHostSetPlugin(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckHostSetResourceDestroy(t, provider, baseHostSetType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckHostSetResourceDestroy(t, provider, baseHostSetType),
 		Steps: []resource.TestStep{
 			{
 				// test project hostset create
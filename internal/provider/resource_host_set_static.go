@@ -272,7 +272,9 @@ func resourceHostSetStaticUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if len(opts) > 0 {
 		opts = append(opts, hostsets.WithAutomaticVersioning(true))
-		_, err := hsClient.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*hostsets.HostSetUpdateResult, error) {
+			return hsClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating host set: %v", err)
 		}
@@ -299,7 +301,9 @@ func resourceHostSetStaticUpdate(ctx context.Context, d *schema.ResourceData, me
 				hostIds = append(hostIds, host.(string))
 			}
 		}
-		_, err := hsClient.SetHosts(ctx, d.Id(), 0, hostIds, hostsets.WithAutomaticVersioning(true))
+		_, err := retryOnVersionConflict(ctx, func() (*hostsets.HostSetUpdateResult, error) {
+			return hsClient.SetHosts(ctx, d.Id(), 0, hostIds, hostsets.WithAutomaticVersioning(true))
+		})
 		if err != nil {
 			return diag.Errorf("error updating hosts in host set: %v", err)
 		}
@@ -316,7 +320,7 @@ func resourceHostSetStaticDelete(ctx context.Context, d *schema.ResourceData, me
 	hsClient := hostsets.NewClient(md.client)
 
 	_, err := hsClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting host set: %s", err.Error())
 	}
 
@@ -280,7 +280,9 @@ func resourceHostStaticUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if len(opts) > 0 {
 		opts = append(opts, hosts.WithAutomaticVersioning(true))
-		_, err := hClient.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*hosts.HostUpdateResult, error) {
+			return hClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating host: %v", err)
 		}
@@ -310,7 +312,7 @@ func resourceHostStaticDelete(ctx context.Context, d *schema.ResourceData, meta
 	hClient := hosts.NewClient(md.client)
 
 	_, err := hClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting host: %v", err)
 	}
 
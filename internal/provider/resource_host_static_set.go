@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hostStaticSetHostsKey       = "hosts"
+	hostStaticSetParallelismKey = "parallelism"
+	hostStaticSetHostSetNameKey = "host_set_name"
+	hostStaticSetHostSetIdKey   = "host_set_id"
+
+	hostStaticSetDefaultParallelism = 4
+)
+
+func resourceHostStaticSet() *schema.Resource {
+	return &schema.Resource{
+		Description: "The static host set resource allows you to manage many Boundary static hosts of a host " +
+			"catalog in a single resource, from a map of host names to addresses. Hosts are created, updated " +
+			"and deleted in parallel batches, and a static host set containing all of them can optionally be " +
+			"managed as well. The hosts should not also be managed by `boundary_host_static` resources.",
+
+		CreateContext: resourceHostStaticSetCreate,
+		ReadContext:   resourceHostStaticSetRead,
+		UpdateContext: resourceHostStaticSetUpdate,
+		DeleteContext: resourceHostStaticSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the static host set resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			HostCatalogIdKey: {
+				Description:  "The ID of the static host catalog the hosts are created in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			hostStaticSetHostsKey: {
+				Description: "A map of host names to their static address, as `<IP>` or a domain name. " +
+					"Port assignment occurs in the target resource definition, do not add :port here.",
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			hostStaticSetParallelismKey: {
+				Description: fmt.Sprintf("How many hosts are created, updated or deleted at the same time. "+
+					"Keep it low enough to stay under the rate limits of the controller. Defaults to `%d`.", hostStaticSetDefaultParallelism),
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      hostStaticSetDefaultParallelism,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			hostStaticSetHostSetNameKey: {
+				Description: "When set, a static host set with this name containing all the hosts is managed too.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			hostStaticSetHostSetIdKey: {
+				Description: "The ID of the host set containing all the hosts, when `host_set_name` is set.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			hostSetHostIdsKey: {
+				Description: "A map of host names to the ID of the host created for them.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceHostStaticSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The ID is set first so the hosts created before a failure are kept in
+	// the state and cleaned up by Terraform
+	d.SetId(id.UniqueId())
+
+	if diags := applyHostStaticSet(ctx, d, meta, nil, d.Get(hostStaticSetHostsKey).(map[string]interface{})); diags.HasError() {
+		return diags
+	}
+	return resourceHostStaticSetRead(ctx, d, meta)
+}
+
+func resourceHostStaticSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	// All the hosts of the catalog are listed at once rather than read one by
+	// one, which would take a request per host
+	hlr, err := hosts.NewClient(md.client).List(ctx, d.Get(HostCatalogIdKey).(string))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the host catalog is gone and its hosts with it
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error listing hosts: %v", err)
+	}
+	existing := map[string]*hosts.Host{}
+	for _, h := range hlr.GetItems() {
+		existing[h.Id] = h
+	}
+
+	addresses := map[string]string{}
+	hostIds := map[string]string{}
+	for _, hostId := range d.Get(hostSetHostIdsKey).(map[string]interface{}) {
+		h, ok := existing[hostId.(string)]
+		if !ok {
+			// the host was deleted outside of Terraform, it will be recreated
+			continue
+		}
+		attrs, err := h.GetStaticHostAttributes()
+		if err != nil {
+			return diag.Errorf("error reading attributes of host %q: %v", h.Id, err)
+		}
+		addresses[h.Name] = attrs.Address
+		hostIds[h.Name] = h.Id
+	}
+
+	if err := d.Set(hostStaticSetHostsKey, addresses); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(hostSetHostIdsKey, hostIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostSetId := d.Get(hostStaticSetHostSetIdKey).(string)
+	if hostSetId == "" {
+		return nil
+	}
+	hsrr, err := hostsets.NewClient(md.client).Read(ctx, hostSetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the host set was deleted outside of Terraform, it will be recreated
+			if err := d.Set(hostStaticSetHostSetIdKey, ""); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set(hostStaticSetHostSetNameKey, ""); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+		return diag.Errorf("error reading host set: %v", err)
+	}
+	if hsrr == nil || hsrr.Item == nil {
+		return diag.Errorf("host set nil after read")
+	}
+	if err := d.Set(hostStaticSetHostSetNameKey, hsrr.Item.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceHostStaticSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges(hostStaticSetHostsKey, hostStaticSetHostSetNameKey) {
+		return nil
+	}
+
+	current, desired := d.GetChange(hostStaticSetHostsKey)
+	if diags := applyHostStaticSet(ctx, d, meta, current.(map[string]interface{}), desired.(map[string]interface{})); diags.HasError() {
+		return diags
+	}
+	return resourceHostStaticSetRead(ctx, d, meta)
+}
+
+func resourceHostStaticSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	if hostSetId := d.Get(hostStaticSetHostSetIdKey).(string); hostSetId != "" {
+		if _, err := hostsets.NewClient(md.client).Delete(ctx, hostSetId); err != nil {
+			if !isNotFoundError(err) {
+				return diag.Errorf("error deleting host set: %v", err)
+			}
+		}
+	}
+
+	hc := hosts.NewClient(md.client)
+	hostIds := d.Get(hostSetHostIdsKey).(map[string]interface{})
+	names := sortedKeys(hostIds)
+
+	var mu sync.Mutex
+	err := runInParallel(ctx, d.Get(hostStaticSetParallelismKey).(int), names, func(ctx context.Context, name string) error {
+		mu.Lock()
+		hostId := hostIds[name].(string)
+		mu.Unlock()
+		if _, err := hc.Delete(ctx, hostId); err != nil {
+			if !isNotFoundError(err) {
+				return fmt.Errorf("error deleting host %q: %w", name, err)
+			}
+		}
+		mu.Lock()
+		delete(hostIds, name)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		// the hosts that are left are kept in the state so deleting can be retried
+		if setErr := d.Set(hostSetHostIdsKey, hostIds); setErr != nil {
+			return diag.FromErr(setErr)
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// applyHostStaticSet creates, updates and deletes hosts to go from the
+// current to the desired map of names to addresses, then brings the host set
+// in line with the hosts. The host_ids attribute is kept up to date with the
+// hosts that were created and deleted, even when an error interrupts the
+// changes.
+func applyHostStaticSet(ctx context.Context, d *schema.ResourceData, meta interface{}, current, desired map[string]interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	hc := hosts.NewClient(md.client)
+	catalogId := d.Get(HostCatalogIdKey).(string)
+
+	hostIds := map[string]string{}
+	for name, hostId := range d.Get(hostSetHostIdsKey).(map[string]interface{}) {
+		hostIds[name] = hostId.(string)
+	}
+
+	create, update, remove := diffHostStaticSet(current, desired, hostIds)
+
+	var mu sync.Mutex
+	parallelism := d.Get(hostStaticSetParallelismKey).(int)
+
+	// Removed hosts are deleted first, then the addresses of the remaining
+	// hosts are updated and finally the new hosts are created
+	err := runInParallel(ctx, parallelism, remove, func(ctx context.Context, name string) error {
+		mu.Lock()
+		hostId := hostIds[name]
+		mu.Unlock()
+		if _, err := hc.Delete(ctx, hostId); err != nil {
+			if !isNotFoundError(err) {
+				return fmt.Errorf("error deleting host %q: %w", name, err)
+			}
+		}
+		mu.Lock()
+		delete(hostIds, name)
+		mu.Unlock()
+		return nil
+	})
+
+	if err == nil {
+		err = runInParallel(ctx, parallelism, update, func(ctx context.Context, name string) error {
+			mu.Lock()
+			hostId := hostIds[name]
+			mu.Unlock()
+			_, err := retryOnVersionConflict(ctx, func() (*hosts.HostUpdateResult, error) {
+				return hc.Update(ctx, hostId, 0,
+					hosts.WithAutomaticVersioning(true),
+					hosts.WithStaticHostAddress(desired[name].(string)),
+				)
+			})
+			if err != nil {
+				return fmt.Errorf("error updating host %q: %w", name, err)
+			}
+			return nil
+		})
+	}
+
+	if err == nil {
+		err = runInParallel(ctx, parallelism, create, func(ctx context.Context, name string) error {
+			hcr, err := hc.Create(ctx, catalogId,
+				hosts.WithName(name),
+				hosts.WithStaticHostAddress(desired[name].(string)),
+			)
+			if err != nil {
+				return fmt.Errorf("error creating host %q: %w", name, err)
+			}
+			if hcr == nil || hcr.Item == nil {
+				return fmt.Errorf("nil host %q after create", name)
+			}
+			mu.Lock()
+			hostIds[name] = hcr.Item.Id
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if setErr := d.Set(hostSetHostIdsKey, hostIds); setErr != nil {
+		return diag.FromErr(setErr)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyHostStaticSetHostSet(ctx, md, d, catalogId, hostIds); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// applyHostStaticSetHostSet creates, updates or deletes the host set so it
+// matches host_set_name and contains exactly the given hosts
+func applyHostStaticSetHostSet(ctx context.Context, md *metaData, d *schema.ResourceData, catalogId string, hostIds map[string]string) error {
+	hsc := hostsets.NewClient(md.client)
+	hostSetId := d.Get(hostStaticSetHostSetIdKey).(string)
+	name := d.Get(hostStaticSetHostSetNameKey).(string)
+
+	if name == "" {
+		if hostSetId == "" {
+			return nil
+		}
+		if _, err := hsc.Delete(ctx, hostSetId); err != nil {
+			if !isNotFoundError(err) {
+				return fmt.Errorf("error deleting host set: %w", err)
+			}
+		}
+		return d.Set(hostStaticSetHostSetIdKey, "")
+	}
+
+	if hostSetId == "" {
+		hscr, err := hsc.Create(ctx, catalogId, hostsets.WithName(name))
+		if err != nil {
+			return fmt.Errorf("error creating host set: %w", err)
+		}
+		if hscr == nil || hscr.Item == nil {
+			return fmt.Errorf("nil host set after create")
+		}
+		hostSetId = hscr.Item.Id
+		if err := d.Set(hostStaticSetHostSetIdKey, hostSetId); err != nil {
+			return err
+		}
+	} else if d.HasChange(hostStaticSetHostSetNameKey) {
+		if _, err := retryOnVersionConflict(ctx, func() (*hostsets.HostSetUpdateResult, error) {
+			return hsc.Update(ctx, hostSetId, 0, hostsets.WithAutomaticVersioning(true), hostsets.WithName(name))
+		}); err != nil {
+			return fmt.Errorf("error updating host set: %w", err)
+		}
+	}
+
+	ids := make([]string, 0, len(hostIds))
+	for _, name := range sortedKeys(hostIds) {
+		ids = append(ids, hostIds[name])
+	}
+	if _, err := retryOnVersionConflict(ctx, func() (*hostsets.HostSetUpdateResult, error) {
+		return hsc.SetHosts(ctx, hostSetId, 0, ids, hostsets.WithAutomaticVersioning(true))
+	}); err != nil {
+		return fmt.Errorf("error setting hosts on host set: %w", err)
+	}
+
+	return nil
+}
+
+// diffHostStaticSet returns, sorted, the names of the hosts to create, update
+// and delete to go from current to desired. Hosts of current that have no ID
+// are created again.
+func diffHostStaticSet(current, desired map[string]interface{}, hostIds map[string]string) (create, update, remove []string) {
+	for name, address := range desired {
+		_, exists := hostIds[name]
+		switch {
+		case !exists:
+			create = append(create, name)
+		case current[name] != address:
+			update = append(update, name)
+		}
+	}
+	for name := range hostIds {
+		if _, ok := desired[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(create)
+	sort.Strings(update)
+	sort.Strings(remove)
+	return create, update, remove
+}
+
+// runInParallel calls fn for every item, running at most parallelism calls at
+// the same time. Once a call fails the items that have not started yet are
+// skipped, but the calls in flight are left to finish so their result is not
+// lost. The errors of all the calls that failed are returned.
+func runInParallel(ctx context.Context, parallelism int, items []string, fn func(context.Context, string) error) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		sem  = make(chan struct{}, parallelism)
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(errs) > 0
+	}
+
+	for _, item := range items {
+		sem <- struct{}{}
+		if failed() || ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(item string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, item); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return ctx.Err()
+	}
+	return errors.Join(errs...)
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const hostStaticSetResc = "boundary_host_static_set.fleet"
+
+var (
+	hostStaticSetCatalog = `
+resource "boundary_host_catalog_static" "fleet" {
+	name       = "fleet"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}`
+
+	hostStaticSet = `
+resource "boundary_host_static_set" "fleet" {
+	host_catalog_id = boundary_host_catalog_static.fleet.id
+	parallelism     = 2
+	host_set_name   = "fleet"
+	hosts = {
+		for i in range(1, 6) : "web-${i}" => "10.0.0.${i}"
+	}
+}`
+
+	hostStaticSetUpdate = `
+resource "boundary_host_static_set" "fleet" {
+	host_catalog_id = boundary_host_catalog_static.fleet.id
+	parallelism     = 2
+	host_set_name   = "web"
+	hosts = {
+		"web-1" = "10.0.1.1"
+		"web-2" = "10.0.0.2"
+		"web-6" = "10.0.0.6"
+	}
+}`
+
+	hostStaticSetWithoutHostSet = `
+resource "boundary_host_static_set" "fleet" {
+	host_catalog_id = boundary_host_catalog_static.fleet.id
+	hosts = {
+		"web-1" = "10.0.1.1"
+	}
+}`
+)
+
+func TestAccHostStaticSet(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	var hostIds []string
+	var hostSetId string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckHostStaticSetDestroy(t, provider, &hostIds, &hostSetId),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, hostStaticSetCatalog, hostStaticSet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".%", "5"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".web-3", "10.0.0.3"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostSetHostIdsKey+".%", "5"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostSetNameKey, "fleet"),
+					testAccCheckHostStaticSetExists(provider, hostStaticSetResc, &hostIds, &hostSetId),
+				),
+			},
+			{
+				// hosts are added, removed and updated and the host set renamed
+				Config: testConfig(url, fooOrg, firstProjectFoo, hostStaticSetCatalog, hostStaticSetUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".%", "3"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".web-1", "10.0.1.1"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".web-6", "10.0.0.6"),
+					resource.TestCheckNoResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".web-3"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostSetNameKey, "web"),
+					testAccCheckHostStaticSetExists(provider, hostStaticSetResc, &hostIds, &hostSetId),
+				),
+			},
+			{
+				// the host set is deleted once host_set_name is unset
+				Config: testConfig(url, fooOrg, firstProjectFoo, hostStaticSetCatalog, hostStaticSetWithoutHostSet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostsKey+".%", "1"),
+					resource.TestCheckResourceAttr(hostStaticSetResc, hostStaticSetHostSetIdKey, ""),
+					testAccCheckHostStaticSetExists(provider, hostStaticSetResc, &hostIds, &hostSetId),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckHostStaticSetExists checks every host of the set exists and, if
+// one is managed, that the host set contains exactly these hosts. The IDs are
+// recorded to check they are destroyed.
+func testAccCheckHostStaticSetExists(testProvider *schema.Provider, name string, hostIds *[]string, hostSetId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		hc := hosts.NewClient(md.client)
+
+		var ids []string
+		prefix := hostSetHostIdsKey + "."
+		for k, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, prefix) || k == prefix+"%" {
+				continue
+			}
+			hrr, err := hc.Read(context.Background(), id)
+			if err != nil {
+				return fmt.Errorf("got an error reading %q: %w", id, err)
+			}
+			if name := strings.TrimPrefix(k, prefix); hrr.Item.Name != name {
+				return fmt.Errorf("host %q has name %q, expected %q", id, hrr.Item.Name, name)
+			}
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		*hostIds = append(*hostIds, ids...)
+
+		id := rs.Primary.Attributes[hostStaticSetHostSetIdKey]
+		if id == "" {
+			return nil
+		}
+		*hostSetId = id
+		hsrr, err := hostsets.NewClient(md.client).Read(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("got an error reading %q: %w", id, err)
+		}
+		got := append([]string(nil), hsrr.Item.HostIds...)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, ids) {
+			return fmt.Errorf("host set %q has hosts %v, expected %v", id, got, ids)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckHostStaticSetDestroy(t *testing.T, testProvider *schema.Provider, hostIds *[]string, hostSetId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+
+		hc := hosts.NewClient(md.client)
+		for _, id := range *hostIds {
+			_, err := hc.Read(context.Background(), id)
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return fmt.Errorf("didn't get a 404 when reading destroyed host %q: %v", id, err)
+			}
+		}
+
+		if *hostSetId != "" {
+			_, err := hostsets.NewClient(md.client).Read(context.Background(), *hostSetId)
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return fmt.Errorf("didn't get a 404 when reading destroyed host set %q: %v", *hostSetId, err)
+			}
+		}
+		return nil
+	}
+}
+
+func TestDiffHostStaticSet(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]interface{}{
+		"kept":    "10.0.0.1",
+		"moved":   "10.0.0.2",
+		"removed": "10.0.0.3",
+		"lost":    "10.0.0.4",
+	}
+	desired := map[string]interface{}{
+		"kept":  "10.0.0.1",
+		"moved": "10.0.1.2",
+		"lost":  "10.0.0.4",
+		"added": "10.0.0.5",
+	}
+	// the host of "lost" was deleted outside of Terraform
+	hostIds := map[string]string{
+		"kept":    "hst_1",
+		"moved":   "hst_2",
+		"removed": "hst_3",
+	}
+
+	create, update, remove := diffHostStaticSet(current, desired, hostIds)
+	if want := []string{"added", "lost"}; !reflect.DeepEqual(create, want) {
+		t.Errorf("got create %v, want %v", create, want)
+	}
+	if want := []string{"moved"}; !reflect.DeepEqual(update, want) {
+		t.Errorf("got update %v, want %v", update, want)
+	}
+	if want := []string{"removed"}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("got remove %v, want %v", remove, want)
+	}
+}
+
+func TestRunInParallel(t *testing.T) {
+	t.Parallel()
+
+	items := make([]string, 50)
+	for i := range items {
+		items[i] = fmt.Sprintf("item-%d", i)
+	}
+
+	t.Run("all items", func(t *testing.T) {
+		t.Parallel()
+
+		var running, maxRunning int32
+		var mu sync.Mutex
+		seen := map[string]bool{}
+		err := runInParallel(context.Background(), 3, items, func(_ context.Context, item string) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			mu.Lock()
+			seen[item] = true
+			mu.Unlock()
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(seen) != len(items) {
+			t.Fatalf("got %d items, want %d", len(seen), len(items))
+		}
+		if maxRunning > 3 {
+			t.Fatalf("got %d calls at the same time, want at most 3", maxRunning)
+		}
+	})
+
+	t.Run("stops after error", func(t *testing.T) {
+		t.Parallel()
+
+		boom := errors.New("boom")
+		var calls int32
+		err := runInParallel(context.Background(), 1, items, func(_ context.Context, item string) error {
+			atomic.AddInt32(&calls, 1)
+			if item == "item-2" {
+				return boom
+			}
+			return nil
+		})
+		if !errors.Is(err, boom) {
+			t.Fatalf("got error %v, want %v", err, boom)
+		}
+		if calls != 3 {
+			t.Fatalf("got %d calls, want 3", calls)
+		}
+	})
+}
@@ -73,8 +73,8 @@ func testAccHost(t *testing.T, static bool) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckHostResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckHostResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test project host create
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// compositeIdentity is the identity of a resource attaching two objects,
+// whose ID is built from the IDs of the objects
+type compositeIdentity struct {
+	attributes []string
+	// id returns the resource ID from the values of the attributes
+	id func(values []string) string
+}
+
+// compositeIdentities are the identities of the resources that are not
+// identified by their ID alone
+var compositeIdentities = map[string]compositeIdentity{
+	"boundary_scope_policy_attachment": {
+		attributes: []string{ScopeIdKey, policyIdKey},
+		id: func(values []string) string {
+			return scopePolicyAttachmentId(values[0], values[1])
+		},
+	},
+	"boundary_target_credential_source": {
+		attributes: []string{TargetIdKey, targetCredentialSourceIdKey},
+		id: func(values []string) string {
+			return targetSourceId(values[0], values[1])
+		},
+	},
+	"boundary_target_host_source": {
+		attributes: []string{TargetIdKey, targetHostSourceIdKey},
+		id: func(values []string) string {
+			return targetSourceId(values[0], values[1])
+		},
+	},
+}
+
+// identityAttributeDescriptions describe the identity attributes whose
+// resource attribute has no description
+var identityAttributeDescriptions = map[string]string{
+	IDKey:       "The ID of the resource.",
+	ScopeIdKey:  "The ID of the scope.",
+	policyIdKey: "The ID of the policy.",
+}
+
+// addResourceIdentities gives every resource of the provider an identity, so
+// import blocks can refer to Boundary objects by identity instead of by ID.
+// The identity is set after the resources are created, read or updated, and
+// the resources can be imported with it.
+func addResourceIdentities(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if r.Identity != nil {
+			continue
+		}
+		attributes := []string{IDKey}
+		c, composite := compositeIdentities[name]
+		if composite {
+			attributes = c.attributes
+		}
+		r.Identity = newResourceIdentity(r, attributes)
+
+		setIdentity := func(d *schema.ResourceData) error {
+			identity, err := d.Identity()
+			if err != nil {
+				return err
+			}
+			if !composite {
+				return identity.Set(IDKey, d.Id())
+			}
+			for _, a := range c.attributes {
+				if err := identity.Set(a, d.Get(a)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		r.CreateContext = withIdentity(r.CreateContext, setIdentity)
+		r.CreateWithoutTimeout = withIdentity(r.CreateWithoutTimeout, setIdentity)
+		r.ReadContext = withIdentity(r.ReadContext, setIdentity)
+		r.ReadWithoutTimeout = withIdentity(r.ReadWithoutTimeout, setIdentity)
+		r.UpdateContext = withIdentity(r.UpdateContext, setIdentity)
+		r.UpdateWithoutTimeout = withIdentity(r.UpdateWithoutTimeout, setIdentity)
+
+		if r.Importer == nil {
+			continue
+		}
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			// An ID is given when not importing by identity
+			if d.Id() == "" {
+				if err := importIdentity(d, attributes, c.id); err != nil {
+					return nil, err
+				}
+			}
+			return importState(ctx, d, meta)
+		}
+	}
+}
+
+// newResourceIdentity returns an identity made of the attributes of r
+func newResourceIdentity(r *schema.Resource, attributes []string) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			identitySchema := make(map[string]*schema.Schema, len(attributes))
+			for _, a := range attributes {
+				description := identityAttributeDescriptions[a]
+				if s, ok := r.Schema[a]; ok && s.Description != "" {
+					description = s.Description
+				}
+				identitySchema[a] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       description,
+				}
+			}
+			return identitySchema
+		},
+	}
+}
+
+// withIdentity returns f setting the identity of the resource once it
+// succeeded, nil is returned when f is nil
+func withIdentity(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, setIdentity func(*schema.ResourceData) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := setIdentity(d); err != nil {
+			return append(diags, diag.Errorf("error setting resource identity: %v", err)...)
+		}
+		return diags
+	}
+}
+
+// importIdentity sets the ID of the resource, and the attributes of composite
+// identities, from the identity given to import it
+func importIdentity(d *schema.ResourceData, attributes []string, id func([]string) string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("error getting identity: %w", err)
+	}
+	values := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		v, ok := identity.GetOk(a)
+		if !ok {
+			return fmt.Errorf("expected identity to contain %q", a)
+		}
+		values = append(values, v.(string))
+	}
+	if id == nil {
+		d.SetId(values[0])
+		return nil
+	}
+	for i, a := range attributes {
+		if err := d.Set(a, values[i]); err != nil {
+			return err
+		}
+	}
+	d.SetId(id(values))
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceIdentities(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p := New()
+	for name, r := range p.ResourcesMap {
+		require.NotNil(t, r.Identity, name)
+		require.NoError(t, r.Identity.InternalIdentityValidate(), name)
+
+		want := []string{IDKey}
+		if c, ok := compositeIdentities[name]; ok {
+			want = c.attributes
+		}
+		identitySchema := r.Identity.SchemaMap()
+		assert.Len(t, identitySchema, len(want), name)
+		for _, a := range want {
+			require.Contains(t, identitySchema, a, name)
+			assert.True(t, identitySchema[a].RequiredForImport, name)
+		}
+	}
+
+	// The framework resources publish their identity too
+	resp, err := testMuxServer(t).GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+	assert.Len(t, resp.IdentitySchemas, len(p.ResourcesMap)+len(frameworkResources))
+	for _, name := range []string{"boundary_role", "boundary_scope", "boundary_target", "boundary_target_rdp", "boundary_target_ssh", "boundary_target_tcp", "boundary_user"} {
+		assert.Contains(t, resp.IdentitySchemas, name)
+	}
+}
+
+func TestResourceIdentityImport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p := New()
+	tests := []struct {
+		resource string
+		identity map[string]string
+		wantId   string
+	}{
+		{
+			resource: "boundary_group",
+			identity: map[string]string{IDKey: "g_1234567890"},
+			wantId:   "g_1234567890",
+		},
+		{
+			resource: "boundary_scope_policy_attachment",
+			identity: map[string]string{ScopeIdKey: "o_1234567890", policyIdKey: "pst_1234567890"},
+			wantId:   "pst_1234567890:o_1234567890",
+		},
+		{
+			resource: "boundary_target_host_source",
+			identity: map[string]string{TargetIdKey: "ttcp_1234567890", targetHostSourceIdKey: "hsst_1234567890"},
+			wantId:   "ttcp_1234567890:hsst_1234567890",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			r := p.ResourcesMap[tt.resource]
+			d := r.Data(&terraform.InstanceState{Identity: tt.identity})
+
+			imported, err := r.Importer.StateContext(ctx, d, nil)
+			require.NoError(t, err)
+			require.Len(t, imported, 1)
+			assert.Equal(t, tt.wantId, imported[0].Id())
+			for a, v := range tt.identity {
+				if a == IDKey {
+					continue
+				}
+				assert.Equal(t, v, imported[0].Get(a), a)
+			}
+		})
+	}
+
+	// Importing by ID still works
+	r := p.ResourcesMap["boundary_scope_policy_attachment"]
+	d := r.Data(&terraform.InstanceState{ID: "pst_1234567890:o_1234567890"})
+	imported, err := r.Importer.StateContext(ctx, d, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "o_1234567890", imported[0].Get(ScopeIdKey))
+	assert.Equal(t, "pst_1234567890", imported[0].Get(policyIdKey))
+}
+
+func TestWithIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			NameKey: {Type: schema.TypeString, Optional: true},
+		},
+	}
+	r.Identity = newResourceIdentity(r, []string{IDKey})
+	setIdentity := func(d *schema.ResourceData) error {
+		identity, err := d.Identity()
+		if err != nil {
+			return err
+		}
+		return identity.Set(IDKey, d.Id())
+	}
+
+	create := withIdentity(func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		d.SetId("r_1234567890")
+		return nil
+	}, setIdentity)
+	d := r.TestResourceData()
+	require.Empty(t, create(ctx, d, nil))
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "r_1234567890", identity.Get(IDKey))
+
+	// No identity is set for a resource that is gone
+	read := withIdentity(func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		d.SetId("")
+		return nil
+	}, setIdentity)
+	d = r.TestResourceData()
+	require.Empty(t, read(ctx, d, nil))
+	identity, err = d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "", identity.Get(IDKey))
+
+	assert.Nil(t, withIdentity(nil, setIdentity))
+}
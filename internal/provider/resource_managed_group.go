@@ -192,7 +192,9 @@ func resourceManagedGroupUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	if len(opts) > 0 {
 		opts = append(opts, managedgroups.WithAutomaticVersioning(true))
-		_, err := grpClient.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*managedgroups.ManagedGroupUpdateResult, error) {
+			return grpClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating managed group: %v", err)
 		}
@@ -222,7 +224,7 @@ func resourceManagedGroupDelete(ctx context.Context, d *schema.ResourceData, met
 	grpClient := managedgroups.NewClient(md.client)
 
 	_, err := grpClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error calling delete managed group: %s", err.Error())
 	}
 
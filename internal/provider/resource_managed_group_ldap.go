@@ -195,7 +195,9 @@ func resourceManagedGroupLdapUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if len(opts) > 0 {
 		opts = append(opts, managedgroups.WithAutomaticVersioning(true))
-		_, err := grpClient.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*managedgroups.ManagedGroupUpdateResult, error) {
+			return grpClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating managed group: %v", err)
 		}
@@ -45,8 +45,8 @@ func TestAccManagedGroupLdap(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckManagedGroupResourceDestroy(t, provider, ldapManagedGroupType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckManagedGroupResourceDestroy(t, provider, ldapManagedGroupType),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -56,8 +56,8 @@ func TestAccManagedGroup(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckManagedGroupResourceDestroy(t, provider, baseManagedGroupType),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckManagedGroupResourceDestroy(t, provider, baseManagedGroupType),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -274,7 +274,9 @@ func resourcePolicyStorageUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if len(opts) > 0 {
 		opts = append(opts, policies.WithAutomaticVersioning(true))
-		p, err := pClient.Update(ctx, d.Id(), 0, opts...)
+		p, err := retryOnVersionConflict(ctx, func() (*policies.PolicyUpdateResult, error) {
+			return pClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error creating storage policy: %v", err)
 		}
@@ -295,7 +297,7 @@ func resourcePolicyStorageDelete(ctx context.Context, d *schema.ResourceData, me
 	pClient := policies.NewClient(md.client)
 
 	_, err := pClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting policy: %v", err)
 	}
 
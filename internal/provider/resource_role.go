@@ -8,14 +8,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 	"unicode"
 
-	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/roles"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const (
@@ -24,348 +30,424 @@ const (
 	roleGrantStringsKey  = "grant_strings"
 )
 
-func resourceRole() *schema.Resource {
-	return &schema.Resource{
-		Description: "The role resource allows you to configure a Boundary role.",
+// roleResource is written with the plugin framework, its schema and state are
+// the ones of the SDK version it replaced
+type roleResource struct {
+	md *metaData
+}
 
-		CreateContext: resourceRoleCreate,
-		ReadContext:   resourceRoleRead,
-		UpdateContext: resourceRoleUpdate,
-		DeleteContext: resourceRoleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var (
+	_ resource.Resource                 = &roleResource{}
+	_ resource.ResourceWithConfigure    = &roleResource{}
+	_ resource.ResourceWithImportState  = &roleResource{}
+	_ resource.ResourceWithIdentity     = &roleResource{}
+	_ resource.ResourceWithUpgradeState = &roleResource{}
+)
+
+func newRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+type roleResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	ScopeID       types.String   `tfsdk:"scope_id"`
+	PrincipalIDs  types.Set      `tfsdk:"principal_ids"`
+	GrantStrings  types.Set      `tfsdk:"grant_strings"`
+	GrantScopeIDs types.Set      `tfsdk:"grant_scope_ids"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type roleResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
 
-		Schema: map[string]*schema.Schema{
-			IDKey: {
-				Description: "The ID of the role.",
-				Type:        schema.TypeString,
-				Computed:    true,
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The role resource allows you to configure a Boundary role.",
+		// the states of the SDK version are at version 0
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			IDKey: schema.StringAttribute{
+				MarkdownDescription: "The ID of the role.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			NameKey: {
-				Description: "The role name. Defaults to the resource name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			NameKey: schema.StringAttribute{
+				MarkdownDescription: "The role name. Defaults to the resource name.",
+				Optional:            true,
 			},
-			DescriptionKey: {
-				Description: "The role description.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			DescriptionKey: schema.StringAttribute{
+				MarkdownDescription: "The role description.",
+				Optional:            true,
 			},
-			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+			ScopeIdKey: schema.StringAttribute{
+				MarkdownDescription: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			rolePrincipalIdsKey: {
-				Description: "A list of principal (user or group) IDs to add as principals on the role.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			rolePrincipalIdsKey: schema.SetAttribute{
+				MarkdownDescription: "A list of principal (user or group) IDs to add as principals on the role.",
+				ElementType:         types.StringType,
+				Optional:            true,
 			},
-			roleGrantStringsKey: {
-				Description: "A list of stringified grants for the role.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			roleGrantStringsKey: schema.SetAttribute{
+				MarkdownDescription: "A list of stringified grants for the role.",
+				ElementType:         types.StringType,
+				Optional:            true,
 			},
-			roleGrantScopeIdsKey: {
-				Description: `A list of scopes for which the grants in this role should apply, which can include the special values "this", "children", or "descendants"`,
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Computed:    true,
+			roleGrantScopeIdsKey: schema.SetAttribute{
+				MarkdownDescription: `A list of scopes for which the grants in this role should apply, which can include the special values "this", "children", or "descendants"`,
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func setFromRoleResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
-	if err := d.Set(NameKey, raw["name"]); err != nil {
-		return err
-	}
-	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
-		return err
-	}
-	if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
-		return err
-	}
-	if err := d.Set(rolePrincipalIdsKey, raw["principal_ids"]); err != nil {
-		return err
+func (r *roleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return sdkStateUpgraders(ctx, r)
+}
+
+func (r *roleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			IDKey: identityschema.StringAttribute{
+				Description:       "The ID of the role.",
+				RequiredForImport: true,
+			},
+		},
 	}
-	if err := d.Set(roleGrantStringsKey, raw["grant_strings"]); err != nil {
-		return err
+}
+
+func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
-	if err := d.Set(roleGrantScopeIdsKey, raw["grant_scope_ids"]); err != nil {
-		return err
+	md, ok := req.ProviderData.(*metaData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *metaData, got %T", req.ProviderData))
+		return
 	}
+	r.md = md
+}
 
-	d.SetId(raw["id"].(string))
-	return nil
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root(IDKey), path.Root(IDKey), req, resp)
 }
 
-func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	md := meta.(*metaData)
+// setFromRoleResponseMap sets the model from the role returned by the
+// controller
+func setFromRoleResponseMap(ctx context.Context, m *roleResourceModel, raw map[string]interface{}) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	m.ID = types.StringValue(raw["id"].(string))
+	m.Name = stringFromResponseMap(raw, "name", m.Name)
+	m.Description = stringFromResponseMap(raw, "description", m.Description)
+	m.ScopeID = stringFromResponseMap(raw, "scope_id", m.ScopeID)
+	m.PrincipalIDs, d = stringSetFromResponseMap(ctx, raw, "principal_ids", m.PrincipalIDs)
+	diags.Append(d...)
+	m.GrantStrings, d = stringSetFromResponseMap(ctx, raw, "grant_strings", m.GrantStrings)
+	diags.Append(d...)
+	m.GrantScopeIDs, d = stringSetFromResponseMap(ctx, raw, "grant_scope_ids", m.GrantScopeIDs)
+	diags.Append(d...)
+	return diags
+}
 
-	var scopeId string
-	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
-		scopeId = scopeIdVal.(string)
-	} else {
-		return diag.Errorf("no scope ID provided")
+// roleGrantStrings returns the grant strings of the model, with a warning for
+// each grant using deprecated fields
+func roleGrantStrings(ctx context.Context, m *roleResourceModel) ([]string, diag.Diagnostics) {
+	grantStrings, diags := stringsFromSet(ctx, m.GrantStrings)
+	if diags.HasError() {
+		return nil, diags
 	}
-
-	opts := []roles.Option{}
-
-	nameVal, ok := d.GetOk(NameKey)
-	if ok {
-		nameStr := nameVal.(string)
-		opts = append(opts, roles.WithName(nameStr))
+	for _, grant := range grantStrings {
+		deprecationNotice, err := checkGrantForDeprecation(grant)
+		if err != nil {
+			diags.AddError("Invalid grant", err.Error())
+			return nil, diags
+		}
+		if deprecationNotice != "" {
+			diags.AddWarning("deprecated field found in grant", deprecationNotice)
+		}
 	}
+	return grantStrings, diags
+}
 
-	descVal, ok := d.GetOk(DescriptionKey)
-	if ok {
-		descStr := descVal.(string)
-		opts = append(opts, roles.WithDescription(descStr))
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var grantScopeIds []string
-	if grantScopeIdsVal, ok := d.GetOk(roleGrantScopeIdsKey); ok {
-		list := grantScopeIdsVal.(*schema.Set).List()
-		grantScopeIds = make([]string, 0, len(list))
-		for _, i := range list {
-			grantScopeIds = append(grantScopeIds, i.(string))
-		}
+	timeout, diags := plan.Timeouts.Create(ctx, resourceTimeoutsFor("boundary_role").create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	var principalIds []string
-	if principalIdsVal, ok := d.GetOk(rolePrincipalIdsKey); ok {
-		list := principalIdsVal.(*schema.Set).List()
-		principalIds = make([]string, 0, len(list))
-		for _, i := range list {
-			principalIds = append(principalIds, i.(string))
-		}
+	opts := []roles.Option{}
+	if !plan.Name.IsNull() {
+		opts = append(opts, roles.WithName(plan.Name.ValueString()))
+	}
+	if !plan.Description.IsNull() {
+		opts = append(opts, roles.WithDescription(plan.Description.ValueString()))
 	}
 
-	var grantStrings []string
-	if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
-		list := grantStringsVal.(*schema.Set).List()
-		grantStrings = make([]string, 0, len(list))
-		for _, grant := range list {
-			deprecationNotice, err := checkGrantForDeprecation(grant.(string))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if deprecationNotice != "" {
-				diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "deprecated field found in grant", Detail: deprecationNotice})
-			}
-			grantStrings = append(grantStrings, grant.(string))
-		}
+	principalIds, diags := stringsFromSet(ctx, plan.PrincipalIDs)
+	resp.Diagnostics.Append(diags...)
+	grantScopeIds, diags := stringsFromSet(ctx, plan.GrantScopeIDs)
+	resp.Diagnostics.Append(diags...)
+	grantStrings, diags := roleGrantStrings(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	rc := roles.NewClient(md.client)
+	rc := roles.NewClient(r.md.client)
 
-	tcr, err := rc.Create(ctx, scopeId, opts...)
+	tcr, err := rc.Create(ctx, plan.ScopeID.ValueString(), opts...)
 	if err != nil {
-		return diag.Errorf("error calling create role: %v", err)
-	}
-	if tcr == nil {
-		return diag.Errorf("nil role after create")
+		resp.Diagnostics.AddError("Error calling create role", err.Error())
+		return
 	}
-	if tcr.Item == nil {
-		return diag.Errorf("nil role item after create")
+	if tcr == nil || tcr.Item == nil {
+		resp.Diagnostics.AddError("Error calling create role", "nil role after create")
+		return
 	}
 	apiResponse := tcr.GetResponse().Map
-	defer func() {
-		if err := setFromRoleResponseMap(d, apiResponse); err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-		}
-	}()
 
 	if principalIds != nil {
-		tspr, err := rc.SetPrincipals(ctx, tcr.Item.Id, 0, principalIds, roles.WithAutomaticVersioning(true))
+		tspr, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.SetPrincipals(ctx, tcr.Item.Id, 0, principalIds, roles.WithAutomaticVersioning(true))
+		})
 		switch {
 		case err != nil:
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting principals", Detail: err.Error()})
+			resp.Diagnostics.AddError("error setting principals", err.Error())
 		case tspr == nil:
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "nil role after setting principals"})
+			resp.Diagnostics.AddError("nil role after setting principals", "")
 		default:
 			apiResponse = tspr.GetResponse().Map
 		}
 	}
 
 	if grantStrings != nil {
-		tsgr, err := rc.SetGrants(ctx, tcr.Item.Id, 0, grantStrings, roles.WithAutomaticVersioning(true))
+		tsgr, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.SetGrants(ctx, tcr.Item.Id, 0, grantStrings, roles.WithAutomaticVersioning(true))
+		})
 		switch {
 		case err != nil:
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grants", Detail: err.Error()})
+			resp.Diagnostics.AddError("error setting grants", err.Error())
 		case tsgr == nil:
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "nil role after setting grants"})
+			resp.Diagnostics.AddError("nil role after setting grants", "")
 		default:
 			apiResponse = tsgr.GetResponse().Map
 		}
 	}
 
 	if grantScopeIds != nil {
-		tsgr, err := rc.SetGrantScopes(ctx, tcr.Item.Id, 0, grantScopeIds, roles.WithAutomaticVersioning(true))
+		tsgr, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.SetGrantScopes(ctx, tcr.Item.Id, 0, grantScopeIds, roles.WithAutomaticVersioning(true))
+		})
 		switch {
 		case err != nil:
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grant scopes", Detail: err.Error()})
+			resp.Diagnostics.AddError("error setting grant scopes", err.Error())
 		case tsgr == nil:
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "nil role after setting grant scope ids"})
+			resp.Diagnostics.AddError("nil role after setting grant scope ids", "")
 		default:
 			apiResponse = tsgr.GetResponse().Map
 		}
 	}
 
-	return diags
+	// The role was created, it is saved even if setting its principals,
+	// grants or grant scopes failed so it is not left behind
+	resp.Diagnostics.Append(setFromRoleResponseMap(ctx, &plan, apiResponse)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, roleResourceIdentityModel{ID: plan.ID})...)
 }
 
-func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	rc := roles.NewClient(md.client)
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, resourceTimeoutsFor("boundary_role").read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	trr, err := rc.Read(ctx, d.Id())
+	trr, err := roles.NewClient(r.md.client).Read(ctx, state.ID.ValueString())
 	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
-			d.SetId("")
-			return nil
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		return diag.Errorf("error calling read role: %v", err)
+		resp.Diagnostics.AddError("Error calling read role", err.Error())
+		return
 	}
 	if trr == nil {
-		return diag.Errorf("role nil after read")
+		resp.Diagnostics.AddError("Error calling read role", "role nil after read")
+		return
 	}
 
-	if err := setFromRoleResponseMap(d, trr.GetResponse().Map); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(setFromRoleResponseMap(ctx, &state, trr.GetResponse().Map)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, roleResourceIdentityModel{ID: state.ID})...)
 }
 
-func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	rc := roles.NewClient(md.client)
+// Update saves the state of the attributes that were updated, the ones that
+// could not be keep their prior state so they are updated again next time
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	opts := []roles.Option{}
+	timeout, diags := plan.Timeouts.Update(ctx, resourceTimeoutsFor("boundary_role").update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	var name *string
-	if d.HasChange(NameKey) {
+	rc := roles.NewClient(r.md.client)
+	id := state.ID.ValueString()
+	updated := state
+	updated.Timeouts = plan.Timeouts
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, roleResourceIdentityModel{ID: updated.ID})...)
+	}()
+
+	opts := []roles.Option{}
+	if !plan.Name.Equal(state.Name) {
 		opts = append(opts, roles.DefaultName())
-		nameVal, ok := d.GetOk(NameKey)
-		if ok {
-			nameStr := nameVal.(string)
-			name = &nameStr
-			opts = append(opts, roles.WithName(nameStr))
+		if !plan.Name.IsNull() {
+			opts = append(opts, roles.WithName(plan.Name.ValueString()))
 		}
 	}
-
-	var desc *string
-	if d.HasChange(DescriptionKey) {
+	if !plan.Description.Equal(state.Description) {
 		opts = append(opts, roles.DefaultDescription())
-		descVal, ok := d.GetOk(DescriptionKey)
-		if ok {
-			descStr := descVal.(string)
-			desc = &descStr
-			opts = append(opts, roles.WithDescription(descStr))
+		if !plan.Description.IsNull() {
+			opts = append(opts, roles.WithDescription(plan.Description.ValueString()))
 		}
 	}
-
 	if len(opts) > 0 {
 		opts = append(opts, roles.WithAutomaticVersioning(true))
-		_, err := rc.Update(ctx, d.Id(), 0, opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.Update(ctx, id, 0, opts...)
+		})
 		if err != nil {
-			return diag.Errorf("error updating target: %v", err)
+			resp.Diagnostics.AddError("Error updating role", err.Error())
+			return
 		}
+		updated.Name = plan.Name
+		updated.Description = plan.Description
 	}
 
-	if d.HasChange(NameKey) {
-		if err := d.Set(NameKey, name); err != nil {
-			return diag.FromErr(err)
+	if !plan.GrantStrings.Equal(state.GrantStrings) {
+		grantStrings, diags := roleGrantStrings(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
 		}
-	}
-	if d.HasChange(DescriptionKey) {
-		if err := d.Set(DescriptionKey, desc); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	var diags diag.Diagnostics
-	if d.HasChange(roleGrantStringsKey) {
-		var grantStrings []string
-		if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
-			grants := grantStringsVal.(*schema.Set).List()
-			for _, grant := range grants {
-				deprecationNotice, err := checkGrantForDeprecation(grant.(string))
-				if err != nil {
-					return diag.FromErr(err)
-				}
-				if deprecationNotice != "" {
-					diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "deprecated field found in grant", Detail: deprecationNotice})
-				}
-				grantStrings = append(grantStrings, grant.(string))
-			}
-		}
-		_, err := rc.SetGrants(ctx, d.Id(), 0, grantStrings, roles.WithAutomaticVersioning(true))
+		_, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.SetGrants(ctx, id, 0, grantStrings, roles.WithAutomaticVersioning(true))
+		})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grants", Detail: err.Error()})
+			resp.Diagnostics.AddError("error setting grants", err.Error())
 		} else {
-			if err := d.Set(roleGrantStringsKey, grantStrings); err != nil {
-				return diag.FromErr(err)
-			}
+			updated.GrantStrings = plan.GrantStrings
 		}
 	}
 
-	if d.HasChange(rolePrincipalIdsKey) {
-		var principalIds []string
-		if principalIdsVal, ok := d.GetOk(rolePrincipalIdsKey); ok {
-			principals := principalIdsVal.(*schema.Set).List()
-			for _, principal := range principals {
-				principalIds = append(principalIds, principal.(string))
-			}
+	if !plan.PrincipalIDs.Equal(state.PrincipalIDs) {
+		principalIds, diags := stringsFromSet(ctx, plan.PrincipalIDs)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
 		}
-		_, err := rc.SetPrincipals(ctx, d.Id(), 0, principalIds, roles.WithAutomaticVersioning(true))
+		_, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.SetPrincipals(ctx, id, 0, principalIds, roles.WithAutomaticVersioning(true))
+		})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting principals", Detail: err.Error()})
+			resp.Diagnostics.AddError("error setting principals", err.Error())
 		} else {
-			if err := d.Set(rolePrincipalIdsKey, principalIds); err != nil {
-				return diag.FromErr(err)
-			}
+			updated.PrincipalIDs = plan.PrincipalIDs
 		}
 	}
 
-	if d.HasChange(roleGrantScopeIdsKey) {
-		var grantScopeIds []string
-		if grantScopeIdsVal, ok := d.GetOk(roleGrantScopeIdsKey); ok {
-			grantScopes := grantScopeIdsVal.(*schema.Set).List()
-			for _, grantScope := range grantScopes {
-				grantScopeIds = append(grantScopeIds, grantScope.(string))
-			}
+	if !plan.GrantScopeIDs.Equal(state.GrantScopeIDs) {
+		grantScopeIds, diags := stringsFromSet(ctx, plan.GrantScopeIDs)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
 		}
-		_, err := rc.SetGrantScopes(ctx, d.Id(), 0, grantScopeIds, roles.WithAutomaticVersioning(true))
+		_, err := retryOnVersionConflict(ctx, func() (*roles.RoleUpdateResult, error) {
+			return rc.SetGrantScopes(ctx, id, 0, grantScopeIds, roles.WithAutomaticVersioning(true))
+		})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grant scopes", Detail: err.Error()})
+			resp.Diagnostics.AddError("error setting grant scopes", err.Error())
 		} else {
-			if err := d.Set(roleGrantScopeIdsKey, grantScopeIds); err != nil {
-				return diag.FromErr(err)
-			}
+			updated.GrantScopeIDs = plan.GrantScopeIDs
 		}
 	}
-
-	return diags
 }
 
-func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	rc := roles.NewClient(md.client)
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	_, err := rc.Delete(ctx, d.Id())
-	if err != nil {
-		return diag.Errorf("error deleting role: %s", err.Error())
+	timeout, diags := state.Timeouts.Delete(ctx, resourceTimeoutsFor("boundary_role").delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	return nil
+	_, err := roles.NewClient(r.md.client).Delete(ctx, state.ID.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting role", err.Error())
+	}
 }
 
 func checkGrantForDeprecation(grantString string) (string, error) {
@@ -57,9 +57,9 @@ func TestAccRoleWithGrantScopes(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// Create with valid grant scopes should create role
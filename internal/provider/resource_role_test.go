@@ -194,8 +194,8 @@ func TestAccRoleToOrgToProject(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test org role create
@@ -248,9 +248,9 @@ func TestAccRoleWithGrants(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// Create should return error due to invalid grant, however the role will still
@@ -307,9 +307,9 @@ func TestAccRoleWithPrincipals(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// Create with invalid principal should create role but return empty plan
@@ -370,8 +370,8 @@ func TestAccRoleWithGroups(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -401,6 +401,50 @@ func TestAccRoleWithGroups(t *testing.T) {
 	})
 }
 
+// TestAccRoleStateCompatibility checks the state written by the last release
+// serving boundary_role with the SDK is used as is by the framework version
+func TestAccRoleStateCompatibility(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+	token := tc.Token().Token
+
+	var provider *schema.Provider
+	factories := providerFactories(&provider)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the last release serving boundary_role with the SDK
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"boundary": {
+						Source:            "hashicorp/boundary",
+						VersionConstraint: "1.5.2",
+					},
+				},
+				Config: testConfigWithToken(url, token, fooOrg, firstProjectFoo, fooUser, projRoleWithPrincipal, projRoleWithGrants),
+			},
+			{
+				// the framework version plans no change from the state of the release
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, firstProjectFoo, fooUser, projRoleWithPrincipal, projRoleWithGrants),
+				PlanOnly:                 true,
+			},
+			{
+				// the state is refreshed and saved by the framework version
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, firstProjectFoo, fooUser, projRoleWithPrincipal, projRoleWithGrantsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.with_principal"),
+					testAccCheckRoleResourcePrincipalsSet(provider, "boundary_role.with_principal", []string{"boundary_user.foo"}),
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.with_grants", []string{readonlyGrant, readonlyGrantUpdate}),
+					resource.TestCheckResourceAttr("boundary_role.with_grants", "name", "with_grants_update"),
+				),
+			},
+		},
+	})
+}
+
 // testAccCheckRoleDestroyed checks the terraform state for the host
 // catalog and returns an error if found.
 //
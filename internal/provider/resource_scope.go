@@ -5,12 +5,17 @@ package provider
 
 import (
 	"context"
-	"net/http"
+	"fmt"
 
-	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/scopes"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const (
@@ -19,107 +24,210 @@ const (
 	scopeAutoCreateDefaultRole = "auto_create_default_role"
 )
 
-func resourceScope() *schema.Resource {
-	return &schema.Resource{
-		Description: "The scope resource allows you to configure a Boundary scope.",
+// scopeResource is written with the plugin framework, its schema and state
+// are the ones of the SDK version it replaced
+type scopeResource struct {
+	md *metaData
+}
 
-		CreateContext: resourceScopeCreate,
-		ReadContext:   resourceScopeRead,
-		UpdateContext: resourceScopeUpdate,
-		DeleteContext: resourceScopeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var (
+	_ resource.Resource                 = &scopeResource{}
+	_ resource.ResourceWithConfigure    = &scopeResource{}
+	_ resource.ResourceWithImportState  = &scopeResource{}
+	_ resource.ResourceWithIdentity     = &scopeResource{}
+	_ resource.ResourceWithUpgradeState = &scopeResource{}
+)
+
+func newScopeResource() resource.Resource {
+	return &scopeResource{}
+}
+
+type scopeResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Description           types.String   `tfsdk:"description"`
+	ScopeID               types.String   `tfsdk:"scope_id"`
+	GlobalScope           types.Bool     `tfsdk:"global_scope"`
+	AutoCreateAdminRole   types.Bool     `tfsdk:"auto_create_admin_role"`
+	AutoCreateDefaultRole types.Bool     `tfsdk:"auto_create_default_role"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+type scopeResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
 
-		Schema: map[string]*schema.Schema{
-			IDKey: {
-				Description: "The ID of the scope.",
-				Type:        schema.TypeString,
-				Computed:    true,
+func (r *scopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scope"
+}
+
+func (r *scopeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The scope resource allows you to configure a Boundary scope.",
+		// the states of the SDK version are at version 0
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			IDKey: schema.StringAttribute{
+				MarkdownDescription: "The ID of the scope.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			NameKey: {
-				Description: "The scope name. Defaults to the resource name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			NameKey: schema.StringAttribute{
+				MarkdownDescription: "The scope name. Defaults to the resource name.",
+				Optional:            true,
 			},
-			DescriptionKey: {
-				Description: "The scope description.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			DescriptionKey: schema.StringAttribute{
+				MarkdownDescription: "The scope description.",
+				Optional:            true,
 			},
-			ScopeIdKey: {
-				Description: "The scope ID containing the sub scope resource.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+			ScopeIdKey: schema.StringAttribute{
+				MarkdownDescription: "The scope ID containing the sub scope resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			scopeGlobalScopeKey: {
-				Description: "Indicates that the scope containing this value is the global scope, which triggers some specialized behavior to allow it to be imported and managed.",
-				Type:        schema.TypeBool,
-				Optional:    true,
+			scopeGlobalScopeKey: schema.BoolAttribute{
+				MarkdownDescription: "Indicates that the scope containing this value is the global scope, which triggers some specialized behavior to allow it to be imported and managed.",
+				Optional:            true,
 			},
-			scopeAutoCreateAdminRole: {
-				Description: "If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives permissions to manage the scope to the provider's user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
-				Type:        schema.TypeBool,
-				Optional:    true,
+			scopeAutoCreateAdminRole: schema.BoolAttribute{
+				MarkdownDescription: "If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives permissions to manage the scope to the provider's user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
+				Optional:            true,
 			},
-			scopeAutoCreateDefaultRole: {
-				Description: "Only relevant when creating an org scope. If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives listing of scopes and auth methods and the ability to authenticate to the anonymous user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
-				Type:        schema.TypeBool,
-				Optional:    true,
+			scopeAutoCreateDefaultRole: schema.BoolAttribute{
+				MarkdownDescription: "Only relevant when creating an org scope. If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives listing of scopes and auth methods and the ability to authenticate to the anonymous user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
+				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func setFromScopeResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
-	if err := d.Set(NameKey, raw["name"]); err != nil {
-		return err
+func (r *scopeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return sdkStateUpgraders(ctx, r)
+}
+
+func (r *scopeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			IDKey: identityschema.StringAttribute{
+				Description:       "The ID of the scope.",
+				RequiredForImport: true,
+			},
+		},
 	}
-	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
-		return err
+}
+
+func (r *scopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
-	if d.Id() == "global" {
-		if err := d.Set(ScopeIdKey, "global"); err != nil {
-			return err
-		}
-	} else {
-		if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
-			return err
-		}
+	md, ok := req.ProviderData.(*metaData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *metaData, got %T", req.ProviderData))
+		return
 	}
+	r.md = md
+}
 
-	d.SetId(raw["id"].(string))
-	return nil
+func (r *scopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root(IDKey), path.Root(IDKey), req, resp)
 }
 
-func resourceScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.Get(scopeGlobalScopeKey).(bool) {
-		d.SetId("global")
-		return resourceScopeRead(ctx, d, meta)
+// setFromScopeResponseMap sets the model from the scope returned by the
+// controller. The global scope has no parent, its scope ID is "global".
+func setFromScopeResponseMap(m *scopeResourceModel, raw map[string]interface{}) {
+	m.ID = types.StringValue(raw["id"].(string))
+	m.Name = stringFromResponseMap(raw, "name", m.Name)
+	m.Description = stringFromResponseMap(raw, "description", m.Description)
+	if m.ID.ValueString() == "global" {
+		m.ScopeID = types.StringValue("global")
+	} else {
+		m.ScopeID = stringFromResponseMap(raw, "scope_id", m.ScopeID)
 	}
+}
 
-	md := meta.(*metaData)
+// scopeUpdateOptions returns the options changing the name and description
+// of prior to the ones of plan, none are returned when they are the same
+func scopeUpdateOptions(plan, prior *scopeResourceModel) []scopes.Option {
+	var opts []scopes.Option
+	if !plan.Name.Equal(prior.Name) {
+		opts = append(opts, scopes.DefaultName())
+		if !plan.Name.IsNull() {
+			opts = append(opts, scopes.WithName(plan.Name.ValueString()))
+		}
+	}
+	if !plan.Description.Equal(prior.Description) {
+		opts = append(opts, scopes.DefaultDescription())
+		if !plan.Description.IsNull() {
+			opts = append(opts, scopes.WithDescription(plan.Description.ValueString()))
+		}
+	}
+	return opts
+}
 
-	var scopeId string
-	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
-		scopeId = scopeIdVal.(string)
-	} else {
-		return diag.Errorf("no scope ID provided")
+func (r *scopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scopeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	opts := []scopes.Option{}
+	timeout, diags := plan.Timeouts.Create(ctx, resourceTimeoutsFor("boundary_scope").create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	scp := scopes.NewClient(r.md.client)
 
-	nameVal, ok := d.GetOk(NameKey)
-	if ok {
-		nameStr := nameVal.(string)
-		opts = append(opts, scopes.WithName(nameStr))
+	if plan.GlobalScope.ValueBool() {
+		// The global scope always exists, it is adopted and its name and
+		// description are set to the configured ones
+		srr, err := scp.Read(ctx, "global")
+		if err != nil {
+			resp.Diagnostics.AddError("Error calling read scope", err.Error())
+			return
+		}
+		current := plan
+		setFromScopeResponseMap(&current, srr.GetResponse().Map)
+		apiResponse := srr.GetResponse().Map
+		if opts := scopeUpdateOptions(&plan, &current); len(opts) > 0 {
+			opts = append(opts, scopes.WithAutomaticVersioning(true))
+			sur, err := retryOnVersionConflict(ctx, func() (*scopes.ScopeUpdateResult, error) {
+				return scp.Update(ctx, "global", 0, opts...)
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Error updating scope", err.Error())
+				return
+			}
+			apiResponse = sur.GetResponse().Map
+		}
+		setFromScopeResponseMap(&plan, apiResponse)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, scopeResourceIdentityModel{ID: plan.ID})...)
+		return
 	}
 
-	descVal, ok := d.GetOk(DescriptionKey)
-	if ok {
-		descStr := descVal.(string)
-		opts = append(opts, scopes.WithDescription(descStr))
+	opts := []scopes.Option{}
+	if !plan.Name.IsNull() {
+		opts = append(opts, scopes.WithName(plan.Name.ValueString()))
+	}
+	if !plan.Description.IsNull() {
+		opts = append(opts, scopes.WithDescription(plan.Description.ValueString()))
 	}
 
 	// Always skip unless overridden, because if you're using TF to manage this
@@ -132,115 +240,118 @@ func resourceScopeCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	// source from the current token, so that the user can be introspected when
 	// defining these roles instead of having to be explicitly defined in
 	// config.
-	if !d.Get(scopeAutoCreateAdminRole).(bool) {
+	if !plan.AutoCreateAdminRole.ValueBool() {
 		opts = append(opts, scopes.WithSkipAdminRoleCreation(true))
 	}
-	if !d.Get(scopeAutoCreateDefaultRole).(bool) {
+	if !plan.AutoCreateDefaultRole.ValueBool() {
 		opts = append(opts, scopes.WithSkipDefaultRoleCreation(true))
 	}
 
-	scp := scopes.NewClient(md.client)
-
-	scr, err := scp.Create(ctx, scopeId, opts...)
+	scr, err := scp.Create(ctx, plan.ScopeID.ValueString(), opts...)
 	if err != nil {
-		return diag.Errorf("error creating scope: %v", err)
+		resp.Diagnostics.AddError("Error creating scope", err.Error())
+		return
 	}
 	if scr == nil {
-		return diag.Errorf("scope nil after create")
+		resp.Diagnostics.AddError("Error creating scope", "scope nil after create")
+		return
 	}
 
-	if err := setFromScopeResponseMap(d, scr.GetResponse().Map); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	setFromScopeResponseMap(&plan, scr.GetResponse().Map)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, scopeResourceIdentityModel{ID: plan.ID})...)
 }
 
-func resourceScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	scp := scopes.NewClient(md.client)
+func (r *scopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scopeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, resourceTimeoutsFor("boundary_scope").read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	srr, err := scp.Read(ctx, d.Id())
+	srr, err := scopes.NewClient(r.md.client).Read(ctx, state.ID.ValueString())
 	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
-			d.SetId("")
-			return nil
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		return diag.Errorf("error calling read scope: %v", err)
+		resp.Diagnostics.AddError("Error calling read scope", err.Error())
+		return
 	}
 	if srr == nil {
-		return diag.Errorf("scope nil after read")
-	}
-
-	if err := setFromScopeResponseMap(d, srr.GetResponse().Map); err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Error calling read scope", "scope nil after read")
+		return
 	}
 
-	return nil
+	setFromScopeResponseMap(&state, srr.GetResponse().Map)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, scopeResourceIdentityModel{ID: state.ID})...)
 }
 
-func resourceScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	scp := scopes.NewClient(md.client)
-
-	opts := []scopes.Option{}
-
-	var name *string
-	if d.HasChange(NameKey) {
-		opts = append(opts, scopes.DefaultName())
-		nameVal, ok := d.GetOk(NameKey)
-		if ok {
-			nameStr := nameVal.(string)
-			name = &nameStr
-			opts = append(opts, scopes.WithName(nameStr))
-		}
+func (r *scopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state scopeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var desc *string
-	if d.HasChange(DescriptionKey) {
-		opts = append(opts, scopes.DefaultDescription())
-		descVal, ok := d.GetOk(DescriptionKey)
-		if ok {
-			descStr := descVal.(string)
-			desc = &descStr
-			opts = append(opts, scopes.WithDescription(descStr))
-		}
+	timeout, diags := plan.Timeouts.Update(ctx, resourceTimeoutsFor("boundary_scope").update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	if len(opts) > 0 {
+	scp := scopes.NewClient(r.md.client)
+	id := state.ID.ValueString()
+
+	if opts := scopeUpdateOptions(&plan, &state); len(opts) > 0 {
 		opts = append(opts, scopes.WithAutomaticVersioning(true))
-		_, err := scp.Update(ctx, d.Id(), 0, opts...)
+		sur, err := retryOnVersionConflict(ctx, func() (*scopes.ScopeUpdateResult, error) {
+			return scp.Update(ctx, id, 0, opts...)
+		})
 		if err != nil {
-			return diag.Errorf("error updating scope: %v", err)
-		}
-	}
-
-	if d.HasChange(NameKey) {
-		if err := d.Set(NameKey, name); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(DescriptionKey) {
-		if err := d.Set(DescriptionKey, desc); err != nil {
-			return diag.FromErr(err)
+			resp.Diagnostics.AddError("Error updating scope", err.Error())
+			return
 		}
+		setFromScopeResponseMap(&plan, sur.GetResponse().Map)
 	}
 
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, scopeResourceIdentityModel{ID: plan.ID})...)
 }
 
-func resourceScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.Get(scopeGlobalScopeKey).(bool) {
-		return nil
+func (r *scopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scopeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The global scope cannot be deleted, it is only removed from the state
+	if state.GlobalScope.ValueBool() {
+		return
 	}
 
-	md := meta.(*metaData)
-	scp := scopes.NewClient(md.client)
-
-	_, err := scp.Delete(ctx, d.Id())
-	if err != nil {
-		return diag.Errorf("error deleting scope: %v", err)
+	timeout, diags := state.Timeouts.Delete(ctx, resourceTimeoutsFor("boundary_scope").delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	return nil
+	_, err := scopes.NewClient(r.md.client).Delete(ctx, state.ID.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting scope", err.Error())
+	}
 }
@@ -24,10 +24,10 @@ func resourceScopeAliasSuffix() *schema.Resource {
 		Description: "The scope alias suffix resource allows you to set an alias suffix for an org or project scope. " +
 			"Global scopes are not valid for alias suffix operations.",
 
-		CreateContext:        resourceScopeAliasSuffixCreate,
-		UpdateWithoutTimeout: resourceScopeAliasSuffixUpdate,
-		ReadWithoutTimeout:   resourceScopeAliasSuffixRead,
-		DeleteWithoutTimeout: resourceScopeAliasSuffixDelete,
+		CreateContext: resourceScopeAliasSuffixCreate,
+		UpdateContext: resourceScopeAliasSuffixUpdate,
+		ReadContext:   resourceScopeAliasSuffixRead,
+		DeleteContext: resourceScopeAliasSuffixDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -70,7 +70,9 @@ func resourceScopeAliasSuffixCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("no alias suffix provided")
 	}
 
-	if _, err := scp.SetAliasSuffix(ctx, scopeId, 0, aliasSuffix, opts...); err != nil {
+	if _, err := retryOnVersionConflict(ctx, func() (*scopes.SetAliasSuffixResult, error) {
+		return scp.SetAliasSuffix(ctx, scopeId, 0, aliasSuffix, opts...)
+	}); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -145,7 +147,9 @@ func resourceScopeAliasSuffixUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("no alias suffix provided")
 	}
 
-	if _, err := scp.SetAliasSuffix(ctx, scopeId, 0, aliasSuffix, opts...); err != nil {
+	if _, err := retryOnVersionConflict(ctx, func() (*scopes.SetAliasSuffixResult, error) {
+		return scp.SetAliasSuffix(ctx, scopeId, 0, aliasSuffix, opts...)
+	}); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -170,7 +174,7 @@ func resourceScopeAliasSuffixDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	if _, err := scp.RemoveAliasSuffix(ctx, scopeId, 0, opts...); err != nil {
+	if _, err := scp.RemoveAliasSuffix(ctx, scopeId, 0, opts...); err != nil && !isNotFoundError(err) {
 		return diag.FromErr(err)
 	}
 
@@ -27,7 +27,7 @@ func resourceScopePolicyAttachment() *schema.Resource {
 		DeleteWithoutTimeout: resourceScopePolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScopePolicyAttachmentImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +71,9 @@ func resourceScopePolicyAttachmentCreate(ctx context.Context, d *schema.Resource
 	// other policy types may be added here and in other switches with this file
 	switch {
 	case strings.HasPrefix(policyId, storagePolicyPrefix):
-		if _, err := scp.AttachStoragePolicy(ctx, scopeId, 0, policyId, opts...); err != nil {
+		if _, err := retryOnVersionConflict(ctx, func() (*scopes.AttachStoragePolicyResult, error) {
+			return scp.AttachStoragePolicy(ctx, scopeId, 0, policyId, opts...)
+		}); err != nil {
 			return diag.FromErr(err)
 		}
 	default:
@@ -82,7 +84,7 @@ func resourceScopePolicyAttachmentCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", policyId, scopeId))
+	d.SetId(scopePolicyAttachmentId(scopeId, policyId))
 
 	return nil
 }
@@ -153,7 +155,7 @@ func resourceScopePolicyAttachmentDelete(ctx context.Context, d *schema.Resource
 
 	switch {
 	case strings.HasPrefix(policyId, storagePolicyPrefix):
-		if _, err := scp.DetachStoragePolicy(ctx, scopeId, 0, opts...); err != nil {
+		if _, err := scp.DetachStoragePolicy(ctx, scopeId, 0, opts...); err != nil && !isNotFoundError(err) {
 			return diag.FromErr(err)
 		}
 	default:
@@ -162,3 +164,26 @@ func resourceScopePolicyAttachmentDelete(ctx context.Context, d *schema.Resource
 
 	return nil
 }
+
+// resourceScopePolicyAttachmentImport sets the scope and the policy from the
+// ID, Read needs both
+func resourceScopePolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	policyId, scopeId, ok := strings.Cut(d.Id(), ":")
+	if !ok || policyId == "" || scopeId == "" {
+		return nil, fmt.Errorf("unexpected ID %q, expected <policy_id>:<scope_id>", d.Id())
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return nil, err
+	}
+	if err := d.Set(policyIdKey, policyId); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// scopePolicyAttachmentId returns the ID of a scope policy attachment
+func scopePolicyAttachmentId(scopeId, policyId string) string {
+	return fmt.Sprintf("%s:%s", policyId, scopeId)
+}
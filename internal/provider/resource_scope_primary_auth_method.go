@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	scopePrimaryAuthMethodForceKey = "force"
+)
+
+func resourceScopePrimaryAuthMethod() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scope primary auth method resource allows you to set the primary auth method of a scope. " +
+			"The scope is updated in a single request so it is never left without a primary auth method when " +
+			"switching between password, OIDC and LDAP auth methods. This resource should not be combined with " +
+			"`is_primary_for_scope` on the auth method resources for the same scope.",
+
+		CreateContext: resourceScopePrimaryAuthMethodSet,
+		ReadContext:   resourceScopePrimaryAuthMethodRead,
+		UpdateContext: resourceScopePrimaryAuthMethodSet,
+		DeleteContext: resourceScopePrimaryAuthMethodDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			ScopeIdKey: {
+				Description:  "The ID of the scope to set the primary auth method of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			AuthMethodIdKey: {
+				Description:  "The ID of the auth method to make primary for the scope.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			scopePrimaryAuthMethodForceKey: {
+				Description: "Allow replacing or removing the primary auth method even when it is the auth method " +
+					"the provider itself authenticated with. Defaults to `false`.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceScopePrimaryAuthMethodSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	authMethodId := d.Get(AuthMethodIdKey).(string)
+
+	srr, err := scp.Read(ctx, scopeId)
+	if err != nil {
+		return diag.Errorf("error reading scope: %v", err)
+	}
+	if srr == nil || srr.Item == nil {
+		return diag.Errorf("scope nil after read")
+	}
+
+	if err := checkPrimaryAuthMethodDemotion(
+		srr.Item.PrimaryAuthMethodId,
+		authMethodId,
+		providerAuthMethodId(ctx, md),
+		d.Get(scopePrimaryAuthMethodForceKey).(bool),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if srr.Item.PrimaryAuthMethodId != authMethodId {
+		// The version read above is passed explicitly so the switch fails
+		// rather than overwriting a concurrent change to the scope
+		if _, err := scp.Update(ctx, scopeId, srr.Item.Version, scopes.WithPrimaryAuthMethodId(authMethodId)); err != nil {
+			return diag.Errorf("error setting primary auth method on scope: %v", err)
+		}
+	}
+
+	d.SetId(scopeId)
+	return resourceScopePrimaryAuthMethodRead(ctx, d, meta)
+}
+
+func resourceScopePrimaryAuthMethodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	srr, err := scp.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading scope: %v", err)
+	}
+	if srr == nil || srr.Item == nil {
+		return diag.Errorf("scope nil after read")
+	}
+
+	if srr.Item.PrimaryAuthMethodId == "" {
+		// no primary auth method is set on this scope anymore, destroy this resource
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(ScopeIdKey, srr.Item.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(AuthMethodIdKey, srr.Item.PrimaryAuthMethodId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScopePrimaryAuthMethodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	srr, err := scp.Read(ctx, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.Errorf("error reading scope: %v", err)
+	}
+	if srr == nil || srr.Item == nil {
+		return diag.Errorf("scope nil after read")
+	}
+
+	// Only clear the primary auth method if it is still the one managed by
+	// this resource, it may have been switched outside of Terraform
+	if srr.Item.PrimaryAuthMethodId == "" || srr.Item.PrimaryAuthMethodId != d.Get(AuthMethodIdKey).(string) {
+		return nil
+	}
+
+	if err := checkPrimaryAuthMethodDemotion(
+		srr.Item.PrimaryAuthMethodId,
+		"",
+		providerAuthMethodId(ctx, md),
+		d.Get(scopePrimaryAuthMethodForceKey).(bool),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := scp.Update(ctx, d.Id(), srr.Item.Version, scopes.DefaultPrimaryAuthMethodId()); err != nil {
+		return diag.Errorf("error removing primary auth method from scope: %v", err)
+	}
+
+	return nil
+}
+
+// checkPrimaryAuthMethodDemotion returns an error when switching the primary
+// auth method from current to desired would demote the auth method the
+// provider authenticated with, unless force is set. An empty desired means
+// the primary auth method is being removed.
+func checkPrimaryAuthMethodDemotion(current, desired, providerAuthMethodId string, force bool) error {
+	switch {
+	case force:
+		return nil
+	case providerAuthMethodId == "":
+		return nil
+	case current != providerAuthMethodId:
+		return nil
+	case current == desired:
+		return nil
+	}
+
+	return fmt.Errorf("refusing to demote auth method %q, the provider is authenticated with it; set %q to true to do so anyway",
+		current, scopePrimaryAuthMethodForceKey)
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+func TestCheckPrimaryAuthMethodDemotion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		current      string
+		desired      string
+		providerAmId string
+		force        bool
+		wantError    bool
+	}{
+		{
+			name:         "unknown provider auth method",
+			current:      "ampw_1234567890",
+			desired:      "amoidc_1234567890",
+			providerAmId: "",
+		},
+		{
+			name:         "current is not the provider auth method",
+			current:      "ampw_1234567890",
+			desired:      "amoidc_1234567890",
+			providerAmId: "amldap_1234567890",
+		},
+		{
+			name:         "no primary auth method yet",
+			current:      "",
+			desired:      "amoidc_1234567890",
+			providerAmId: "ampw_1234567890",
+		},
+		{
+			name:         "unchanged",
+			current:      "ampw_1234567890",
+			desired:      "ampw_1234567890",
+			providerAmId: "ampw_1234567890",
+		},
+		{
+			name:         "demoting the provider auth method",
+			current:      "ampw_1234567890",
+			desired:      "amoidc_1234567890",
+			providerAmId: "ampw_1234567890",
+			wantError:    true,
+		},
+		{
+			name:         "removing the provider auth method",
+			current:      "ampw_1234567890",
+			desired:      "",
+			providerAmId: "ampw_1234567890",
+			wantError:    true,
+		},
+		{
+			name:         "demoting the provider auth method with force",
+			current:      "ampw_1234567890",
+			desired:      "amoidc_1234567890",
+			providerAmId: "ampw_1234567890",
+			force:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkPrimaryAuthMethodDemotion(tt.current, tt.desired, tt.providerAmId, tt.force)
+			if tt.wantError && err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestProviderAuthMethodId(t *testing.T) {
+	t.Parallel()
+
+	var reads atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/auth-tokens/at_1234567890" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		reads.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"at_1234567890","auth_method_id":"ampw_1234567890"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetAddr(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("at_1234567890_secret")
+	md := &metaData{client: client}
+
+	// resources are handled in parallel, the token is only read once
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := providerAuthMethodId(context.Background(), md); got != "ampw_1234567890" {
+				t.Errorf("unexpected auth method ID %q", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := reads.Load(); got != 1 {
+		t.Fatalf("expected the token to be read once, got %d reads", got)
+	}
+
+	// the auth method the provider logged in with is used as is
+	md = &metaData{client: client, authMethodId: "amldap_1234567890"}
+	if got := providerAuthMethodId(context.Background(), md); got != "amldap_1234567890" {
+		t.Fatalf("unexpected auth method ID %q", got)
+	}
+	if got := reads.Load(); got != 1 {
+		t.Fatalf("expected no token read, got %d reads", got)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const scopePrimaryAuthMethodResc = "boundary_scope_primary_auth_method.org"
+
+var (
+	scopePrimaryAuthMethodPasswords = `
+resource "boundary_auth_method_password" "first" {
+	name       = "first"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+resource "boundary_auth_method_password" "second" {
+	name       = "second"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}`
+
+	scopePrimaryAuthMethodFirst = `
+resource "boundary_scope_primary_auth_method" "org" {
+	scope_id       = boundary_scope.org1.id
+	auth_method_id = boundary_auth_method_password.first.id
+}`
+
+	scopePrimaryAuthMethodSecond = `
+resource "boundary_scope_primary_auth_method" "org" {
+	scope_id       = boundary_scope.org1.id
+	auth_method_id = boundary_auth_method_password.second.id
+}`
+
+	scopePrimaryAuthMethodGlobal = `
+resource "boundary_auth_method_password" "global" {
+	name     = "other"
+	scope_id = "global"
+}
+
+resource "boundary_scope_primary_auth_method" "global" {
+	scope_id       = "global"
+	auth_method_id = boundary_auth_method_password.global.id
+}`
+)
+
+func TestAccScopePrimaryAuthMethod(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, scopePrimaryAuthMethodPasswords, scopePrimaryAuthMethodFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(scopePrimaryAuthMethodResc, AuthMethodIdKey, "boundary_auth_method_password.first", IDKey),
+					resource.TestCheckResourceAttrPair(scopePrimaryAuthMethodResc, IDKey, "boundary_scope.org1", IDKey),
+					testAccIsPrimaryForScope(provider, "boundary_auth_method_password.first", true),
+				),
+			},
+			importStep(scopePrimaryAuthMethodResc),
+			{
+				// switch
+				Config: testConfig(url, fooOrg, scopePrimaryAuthMethodPasswords, scopePrimaryAuthMethodSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(scopePrimaryAuthMethodResc, AuthMethodIdKey, "boundary_auth_method_password.second", IDKey),
+					testAccIsPrimaryForScope(provider, "boundary_auth_method_password.first", false),
+					testAccIsPrimaryForScope(provider, "boundary_auth_method_password.second", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScopePrimaryAuthMethodRefusesProviderAuthMethod(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(url, scopePrimaryAuthMethodGlobal),
+				ExpectError: regexp.MustCompile("refusing to demote auth method"),
+			},
+		},
+	})
+}
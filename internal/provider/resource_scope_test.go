@@ -94,8 +94,8 @@ func TestAccScopeCreation(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckScopeResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				Config: testConfig(url, fooOrg, firstProjectFoo, secondProject),
@@ -131,6 +131,50 @@ func TestAccScopeCreation(t *testing.T) {
 	})
 }
 
+// TestAccScopeStateCompatibility checks the state written by the last release
+// serving boundary_scope with the SDK is used as is by the framework version
+func TestAccScopeStateCompatibility(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+	token := tc.Token().Token
+
+	var provider *schema.Provider
+	factories := providerFactories(&provider)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the last release serving boundary_scope with the SDK
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"boundary": {
+						Source:            "hashicorp/boundary",
+						VersionConstraint: "1.5.2",
+					},
+				},
+				Config: testConfigWithToken(url, token, fooOrg, firstProjectFoo),
+			},
+			{
+				// the framework version plans no change from the state of the release
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, firstProjectFoo),
+				PlanOnly:                 true,
+			},
+			{
+				// the state is refreshed and saved by the framework version
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, firstProjectBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeResourceExists(provider, "boundary_scope.org1"),
+					testAccCheckScopeResourceExists(provider, "boundary_scope.proj1"),
+					resource.TestCheckResourceAttr("boundary_scope.global", IDKey, "global"),
+					resource.TestCheckResourceAttr("boundary_scope.proj1", DescriptionKey, "bar"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckScopeResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	reapplyStoragePolicyTriggersKey = "triggers"
+	reapplyStoragePolicyIdsKey      = "session_recording_ids"
+)
+
+func resourceSessionRecordingReapplyStoragePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "The session recording reapply storage policy resource reapplies the current storage policy " +
+			"to the existing session recordings of a scope, so that a change to a `boundary_policy_storage` also " +
+			"updates the `retain_until` and `delete_after` of the recordings made before it. The policy is " +
+			"reapplied when the resource is created and every time `triggers` changes; destroying the resource " +
+			"does not change any recording. Session recordings are a Boundary Enterprise feature.",
+
+		CreateContext: resourceSessionRecordingReapplyStoragePolicyCreate,
+		ReadContext:   resourceSessionRecordingReapplyStoragePolicyRead,
+		DeleteContext: resourceSessionRecordingReapplyStoragePolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			ScopeIdKey: {
+				Description:  "The ID of the scope whose session recordings get the storage policy reapplied.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			RecursiveKey: {
+				Description: "Whether the session recordings of the child scopes are included. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			TargetIdKey: {
+				Description: "Only reapply the storage policy to the session recordings of sessions to this target.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			UserIdKey: {
+				Description: "Only reapply the storage policy to the session recordings of sessions held by this user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			targetStorageBucketIdKey: {
+				Description: "Only reapply the storage policy to the session recordings stored in this storage bucket.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			reapplyStoragePolicyTriggersKey: {
+				Description: "Arbitrary values that cause the storage policy to be reapplied when they change, " +
+					"e.g. the ID and retention settings of the storage policy.",
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			reapplyStoragePolicyIdsKey: {
+				Description: "The IDs of the session recordings the storage policy was reapplied to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSessionRecordingReapplyStoragePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	src := sessionrecordings.NewClient(md.client)
+
+	recordings, err := listSessionRecordings(ctx, md, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := []string{}
+	for _, r := range recordings {
+		if _, err := src.ReApplyStoragePolicy(ctx, r.Id); err != nil {
+			return diag.Errorf("error reapplying storage policy to session recording %q: %v", r.Id, err)
+		}
+		ids = append(ids, r.Id)
+	}
+
+	if err := d.Set(reapplyStoragePolicyIdsKey, ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+	return nil
+}
+
+// The storage policy is only reapplied on create, there is nothing to refresh
+func resourceSessionRecordingReapplyStoragePolicyRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// Destroying the resource leaves the session recordings untouched
+func resourceSessionRecordingReapplyStoragePolicyDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const reapplyStoragePolicyResourceName = "boundary_session_recording_reapply_storage_policy.foo"
+
+var (
+	fooReapplyStoragePolicy = `
+resource "boundary_session_recording_reapply_storage_policy" "foo" {
+	depends_on = [boundary_role.proj1_admin]
+	scope_id   = boundary_scope.org1.id
+	recursive  = true
+
+	triggers = {
+		quarter = "2026-Q3"
+	}
+}`
+
+	fooReapplyStoragePolicyUpdate = `
+resource "boundary_session_recording_reapply_storage_policy" "foo" {
+	depends_on = [boundary_role.proj1_admin]
+	scope_id   = boundary_scope.org1.id
+	recursive  = true
+
+	triggers = {
+		quarter = "2026-Q4"
+	}
+}`
+)
+
+func TestAccSessionRecordingReapplyStoragePolicy(t *testing.T) {
+	t.Skip("Skipping test until Boundary Terraform Provider can unit tests for Boundary Enterprise only features")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooReapplyStoragePolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(reapplyStoragePolicyResourceName, IDKey),
+					resource.TestCheckResourceAttr(reapplyStoragePolicyResourceName, reapplyStoragePolicyIdsKey+".#", "0"),
+				),
+			},
+			{
+				// changing the triggers reapplies the storage policy
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooReapplyStoragePolicyUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(reapplyStoragePolicyResourceName, reapplyStoragePolicyTriggersKey+".quarter", "2026-Q4"),
+				),
+			},
+		},
+	})
+}
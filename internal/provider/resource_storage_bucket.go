@@ -28,7 +28,8 @@ func resourceStorageBucket() *schema.Resource {
 	return &schema.Resource{
 		Description: `The storage bucket resource allows you to configure a Boundary storage bucket. ` +
 			`A storage bucket can only belong to the Global scope or an Org scope. ` +
-			`At this time, the only supported storage for storage buckets is AWS S3. ` +
+			`Supported storage for storage buckets is AWS S3 and MinIO, configured through the "aws" or "minio" blocks or ` +
+			`through "attributes_json" and "secrets_json". ` +
 			`This feature requires Boundary Enterprise or Boundary HCP.`,
 		CreateContext: resourceStorageBucketCreate,
 		ReadContext:   resourceStorageBucketRead,
@@ -81,9 +82,10 @@ func resourceStorageBucket() *schema.Resource {
 				Description: `The secrets for the storage bucket. Either values encoded with the "jsonencode" function, pre-escaped JSON string, ` +
 					`or a file:// or env:// path. Set to a string "null" to clear any existing values. NOTE: Unlike "attributes_json", removing ` +
 					`this block will NOT clear secrets from the storage bucket; this allows injecting secrets for one call, then removing them for storage.`,
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{storageBucketAwsKey, storageBucketMinioKey},
 			},
 			SecretsHmacKey: {
 				Description: "The HMAC'd secrets value returned from the server.",
@@ -114,8 +116,9 @@ func resourceStorageBucket() *schema.Resource {
 				Description: `The attributes for the storage bucket. The "region" attribute field is required when creating an AWS storage bucket. ` +
 					`Values are either encoded with the "jsonencode" function, pre-escaped JSON string, ` +
 					`or a file:// or env:// path. Set to a string "null" or remove the block to clear all attributes in the storage bucket.`,
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{storageBucketAwsKey, storageBucketMinioKey},
 				// If set to null in config and nothing comes from API, consider
 				// it the same. Same if config changes from empty to null.
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
@@ -136,6 +139,8 @@ func resourceStorageBucket() *schema.Resource {
 					}
 				},
 			},
+			storageBucketAwsKey:   storageBucketAwsSchema(),
+			storageBucketMinioKey: storageBucketMinioSchema(),
 			WorkerFilterKey: {
 				Description: `Filters to the worker(s) that can handle requests for this storage bucket. The filter must match an existing ` +
 					`worker in order to create a storage bucket.`,
@@ -152,6 +157,14 @@ func resourceStorageBucket() *schema.Resource {
 		// We want to always force an update (which itself may not actually do
 		// anything) so that we can properly check secrets state.
 		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if key, block, ok := storageBucketPluginBlock(d); ok && storageBucketPluginBlockKnown(d, key) {
+				if err := validateStorageBucketPluginBlock(key, block, d.Id() == ""); err != nil {
+					return err
+				}
+				if err := validateStorageBucketPluginName(key, d.Get(PluginNameKey).(string)); err != nil {
+					return err
+				}
+			}
 			return d.SetNewComputed(internalForceUpdateKey)
 		},
 	}
@@ -197,6 +210,15 @@ func setFromStorageBucketResponseMap(d *schema.ResourceData, raw map[string]inte
 	// Attributes stuff
 	{
 		attrRaw, ok := raw["attributes"]
+		if key, block, blockOk := storageBucketPluginBlock(d); blockOk {
+			// The typed block owns the attributes, so attributes_json is left
+			// unset to avoid a diff against config
+			attrs, _ := attrRaw.(map[string]interface{})
+			if err := d.Set(key, flattenStorageBucketPluginBlock(key, attrs, block)); err != nil {
+				return err
+			}
+			ok = false
+		}
 		switch ok {
 		case true:
 			encodedAttributes, err := json.Marshal(attrRaw)
@@ -275,8 +297,10 @@ func resourceStorageBucketCreate(ctx context.Context, d *schema.ResourceData, me
 		opts = append(opts, storagebuckets.WithWorkerFilter(workerFilterVal.(string)))
 	}
 
-	attrsVal, ok := d.GetOk(AttributesJsonKey)
-	if ok {
+	if key, block, ok := storageBucketPluginBlock(d); ok {
+		attrs, _ := expandStorageBucketPluginBlock(key, block)
+		opts = append(opts, storagebuckets.WithAttributes(attrs))
+	} else if attrsVal, ok := d.GetOk(AttributesJsonKey); ok {
 		attrsStr, err := parseutil.ParsePath(attrsVal.(string))
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 			return diag.Errorf("error parsing path with attributes: %v", err)
@@ -295,14 +319,11 @@ func resourceStorageBucketCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	secretsVal, ok := d.GetOk(SecretsJsonKey)
-	var secretsJson string
-	if ok {
-		var err error
-		secretsJson, err = parseutil.ParsePath(secretsVal.(string))
-		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
-			return diag.Errorf("error parsing path with secrets: %v", err)
-		}
+	secretsJson, err := storageBucketSecretsJson(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secretsJson != "" {
 		switch secretsJson {
 		case "null":
 			opts = append(opts, storagebuckets.DefaultSecrets())
@@ -395,10 +416,10 @@ func resourceStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, me
 		if secretsHmacRaw, ok := sbrr.GetResponse().Map[SecretsHmacKey]; ok {
 			serverSecretsHmac = secretsHmacRaw.(string)
 		}
-		// Get current secrets_json value
-		secretsJson, err = parseutil.ParsePath(d.Get(SecretsJsonKey).(string))
-		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
-			return diag.Errorf("error parsing path with secrets: %v", err)
+		// Get current secrets value from secrets_json or the typed plugin block
+		secretsJson, err = storageBucketSecretsJson(d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 		// Now that we have the value from the server, see if anything needs to be
 		// done
@@ -457,27 +478,15 @@ func resourceStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(AttributesJsonKey) {
-		attrsVal, ok := d.GetOk(AttributesJsonKey)
-		if ok {
-			attrsStr, err := parseutil.ParsePath(attrsVal.(string))
-			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
-				return append(currentDiagnostics, diag.Errorf("error parsing path with attributes: %v", err)...)
-			}
-			switch attrsStr {
-			case "null", "":
-				opts = append(opts, storagebuckets.DefaultAttributes())
-			default:
-				// What comes in is json-encoded but we want to set a
-				// map[string]interface{} so we unmarshal it and set that
-				var m map[string]interface{}
-				if err := json.Unmarshal([]byte(attrsStr), &m); err != nil {
-					return append(currentDiagnostics, diag.Errorf("error unmarshaling attributes: %v", err)...)
-				}
-				opts = append(opts, storagebuckets.WithAttributes(m))
-			}
-		} else {
+	if d.HasChanges(storageBucketAwsKey, storageBucketMinioKey, AttributesJsonKey) {
+		attrs, err := storageBucketUpdateAttributes(d)
+		if err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+		if attrs == nil {
 			opts = append(opts, storagebuckets.DefaultAttributes())
+		} else {
+			opts = append(opts, storagebuckets.WithAttributes(attrs))
 		}
 	}
 
@@ -498,7 +507,9 @@ func resourceStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if len(opts) > 0 {
 		opts = append(opts, storagebuckets.WithAutomaticVersioning(true))
-		sbur, err := sbClient.Update(ctx, d.Id(), 0, opts...)
+		sbur, err := retryOnVersionConflict(ctx, func() (*storagebuckets.StorageBucketUpdateResult, error) {
+			return sbClient.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return append(currentDiagnostics, diag.Errorf("error updating storage bucket: %v", err)...)
 		}
@@ -544,7 +555,7 @@ func resourceStorageBucketDelete(ctx context.Context, d *schema.ResourceData, me
 	sbClient := storagebuckets.NewClient(md.client)
 
 	_, err := sbClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting storage bucket: %v", err)
 	}
 
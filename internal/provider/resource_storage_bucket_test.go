@@ -145,8 +145,8 @@ func TestAccStorageBucket(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckStorageBucketResourceDestroy(t, provider, "boundary_storage_bucket"),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckStorageBucketResourceDestroy(t, provider, "boundary_storage_bucket"),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -5,14 +5,22 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"math"
-	"net/http"
 
-	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const (
@@ -35,809 +43,797 @@ const (
 	targetTypeRdp = "rdp"
 )
 
-func resourceTarget() *schema.Resource {
-	return &schema.Resource{
-		Description: "The target resource allows you to configure a Boundary target.",
+// targetResource is written with the plugin framework, its schema and state
+// are the ones of the SDK version it replaced. boundary_target_tcp,
+// boundary_target_ssh and boundary_target_rdp share its implementation,
+// targetType is empty for boundary_target.
+type targetResource struct {
+	md          *metaData
+	targetType  string
+	displayName string
+}
 
-		CreateContext: resourceTargetCreate,
-		ReadContext:   resourceTargetRead,
-		UpdateContext: resourceTargetUpdate,
-		DeleteContext: resourceTargetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var (
+	_ resource.Resource                   = &targetResource{}
+	_ resource.ResourceWithConfigure      = &targetResource{}
+	_ resource.ResourceWithImportState    = &targetResource{}
+	_ resource.ResourceWithIdentity       = &targetResource{}
+	_ resource.ResourceWithUpgradeState   = &targetResource{}
+	_ resource.ResourceWithValidateConfig = &targetResource{}
+	_ resource.ResourceWithModifyPlan     = &targetResource{}
+)
+
+func newTargetResource() resource.Resource {
+	return &targetResource{}
+}
+
+// targetTcpResourceModel holds the attributes every target resource has,
+// which are all the attributes of boundary_target_tcp
+type targetTcpResourceModel struct {
+	ID                          types.String   `tfsdk:"id"`
+	Name                        types.String   `tfsdk:"name"`
+	Description                 types.String   `tfsdk:"description"`
+	Type                        types.String   `tfsdk:"type"`
+	ScopeID                     types.String   `tfsdk:"scope_id"`
+	DefaultPort                 types.Int64    `tfsdk:"default_port"`
+	DefaultClientPort           types.Int64    `tfsdk:"default_client_port"`
+	HostSourceIDs               types.Set      `tfsdk:"host_source_ids"`
+	BrokeredCredentialSourceIDs types.Set      `tfsdk:"brokered_credential_source_ids"`
+	SessionMaxSeconds           types.Int64    `tfsdk:"session_max_seconds"`
+	SessionConnectionLimit      types.Int64    `tfsdk:"session_connection_limit"`
+	WorkerFilter                types.String   `tfsdk:"worker_filter"`
+	EgressWorkerFilter          types.String   `tfsdk:"egress_worker_filter"`
+	IngressWorkerFilter         types.String   `tfsdk:"ingress_worker_filter"`
+	Address                     types.String   `tfsdk:"address"`
+	SessionHandling             types.List     `tfsdk:"session_handling"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// targetResourceModel adds the attributes of the targets supporting injected
+// credentials and session recording
+type targetResourceModel struct {
+	targetTcpResourceModel
+	InjectedAppCredentialSourceIDs types.Set    `tfsdk:"injected_application_credential_source_ids"`
+	EnableSessionRecording         types.Bool   `tfsdk:"enable_session_recording"`
+	StorageBucketID                types.String `tfsdk:"storage_bucket_id"`
+}
 
-		Schema: map[string]*schema.Schema{
-			IDKey: {
-				Description: "The ID of the target.",
-				Type:        schema.TypeString,
-				Computed:    true,
+type targetResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// typeName returns the name of the resource
+func (r *targetResource) typeName() string {
+	if r.targetType == "" {
+		return "boundary_target"
+	}
+	return "boundary_target_" + r.targetType
+}
+
+func (r *targetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target"
+	if r.targetType != "" {
+		resp.TypeName += "_" + r.targetType
+	}
+}
+
+func (r *targetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The target resource allows you to configure a Boundary target.",
+		// the states of the SDK version are at version 0
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			IDKey: schema.StringAttribute{
+				MarkdownDescription: "The ID of the target.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			NameKey: {
-				Description: "The target name. Defaults to the resource name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			NameKey: schema.StringAttribute{
+				MarkdownDescription: "The target name. Defaults to the resource name.",
+				Optional:            true,
 			},
-			DescriptionKey: {
-				Description: "The target description.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			DescriptionKey: schema.StringAttribute{
+				MarkdownDescription: "The target description.",
+				Optional:            true,
 			},
-			TypeKey: {
-				Description: "The target resource type.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+			TypeKey: schema.StringAttribute{
+				MarkdownDescription: "The target resource type, one of `tcp`, `ssh` or `rdp`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+			ScopeIdKey: schema.StringAttribute{
+				MarkdownDescription: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			targetDefaultPortKey: {
-				Description: "The default port for this target.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+			targetDefaultPortKey: schema.Int64Attribute{
+				MarkdownDescription: "The default port for this target.",
+				Optional:            true,
 			},
-			targetDefaultClientPortKey: {
-				Description: "The default client port for this target.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+			targetDefaultClientPortKey: schema.Int64Attribute{
+				MarkdownDescription: "The default client port for this target.",
+				Optional:            true,
 			},
-			targetHostSourceIdsKey: {
-				Description:   "A list of host source ID's. Cannot be used alongside address.",
-				Type:          schema.TypeSet,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{targetAddressKey},
+			targetHostSourceIdsKey: schema.SetAttribute{
+				MarkdownDescription: "A list of host source ID's. Cannot be used alongside address. " +
+					"Ignore changes to this attribute when host sources are attached with `boundary_target_host_source`.",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
-			targetBrokeredCredentialSourceIdsKey: {
-				Description: "A list of brokered credential source ID's.",
-				Type:        schema.TypeSet,
+			targetBrokeredCredentialSourceIdsKey: schema.SetAttribute{
+				MarkdownDescription: "A list of brokered credential source ID's. " +
+					"Ignore changes to this attribute when credential sources are attached with `boundary_target_credential_source`.",
+				ElementType: types.StringType,
 				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			targetInjectedAppCredentialSourceIdsKey: {
-				Description: "A list of injected application credential source ID's.",
-				Type:        schema.TypeSet,
+			targetInjectedAppCredentialSourceIdsKey: schema.SetAttribute{
+				MarkdownDescription: "A list of injected application credential source ID's. " +
+					"Ignore changes to this attribute when credential sources are attached with `boundary_target_credential_source`.",
+				ElementType: types.StringType,
 				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			targetSessionMaxSecondsKey: {
-				Type:     schema.TypeInt,
+			targetSessionMaxSecondsKey: schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
-			targetSessionConnectionLimitKey: {
-				Type:     schema.TypeInt,
+			targetSessionConnectionLimitKey: schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
-			targetWorkerFilterKey: {
-				Description: "Boolean expression to filter the workers for this target",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Deprecated:  "Deprecated. Use `egress_worker_filter` and `ingress_worker_filter` instead",
+			targetWorkerFilterKey: schema.StringAttribute{
+				MarkdownDescription: "Boolean expression to filter the workers for this target",
+				Optional:            true,
+				DeprecationMessage:  "Deprecated. Use `egress_worker_filter` and `ingress_worker_filter` instead",
 			},
-			targetWorkerEgressFilterKey: {
-				Description: "Boolean expression to filter the workers used to access this target",
-				Type:        schema.TypeString,
-				Optional:    true,
+			targetWorkerEgressFilterKey: schema.StringAttribute{
+				MarkdownDescription: "Boolean expression to filter the workers used to access this target",
+				Optional:            true,
 			},
-			targetWorkerIngressFilterKey: {
-				Description: "HCP Only. Boolean expression to filter the workers a user will connect to when initiating a session against this target",
-				Type:        schema.TypeString,
-				Optional:    true,
+			targetWorkerIngressFilterKey: schema.StringAttribute{
+				MarkdownDescription: "HCP Only. Boolean expression to filter the workers a user will connect to when initiating a session against this target",
+				Optional:            true,
 			},
-			targetAddressKey: {
-				Description:   "Optionally, a valid network address to connect to for this target. Cannot be used alongside host_source_ids.",
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{targetHostSourceIdsKey},
+			targetAddressKey: schema.StringAttribute{
+				MarkdownDescription: "Optionally, a valid network address to connect to for this target. Cannot be used alongside host_source_ids.",
+				Optional:            true,
 			},
-			targetEnableSessionRecordingKey: {
-				Description: "HCP/Ent Only. Enable sessions recording for this target. Only applicable for SSH and RDP targets.",
-				Type:        schema.TypeBool,
-				Optional:    true,
+			targetEnableSessionRecordingKey: schema.BoolAttribute{
+				MarkdownDescription: "HCP/Ent Only. Enable sessions recording for this target. Only applicable for SSH and RDP targets, requires `storage_bucket_id`.",
+				Optional:            true,
 			},
-			targetStorageBucketIdKey: {
-				Description: "HCP/Ent Only. Storage bucket for this target. Only applicable for SSH and RDP targets.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			targetStorageBucketIdKey: schema.StringAttribute{
+				MarkdownDescription: "HCP/Ent Only. Storage bucket for this target. Only applicable for SSH and RDP targets.",
+				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			targetSessionHandlingKey: targetSessionHandlingBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+	if r.targetType != "" {
+		r.typedSchema(&resp.Schema)
 	}
 }
 
-func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
-	if err := d.Set(NameKey, raw["name"]); err != nil {
-		return err
+func (r *targetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return sdkStateUpgraders(ctx, r)
+}
+
+func (r *targetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			IDKey: identityschema.StringAttribute{
+				Description:       "The ID of the target.",
+				RequiredForImport: true,
+			},
+		},
 	}
-	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
-		return err
+}
+
+func (r *targetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
-	if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
-		return err
+	md, ok := req.ProviderData.(*metaData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *metaData, got %T", req.ProviderData))
+		return
 	}
-	if err := d.Set(TypeKey, raw["type"]); err != nil {
-		return err
+	r.md = md
+}
+
+func (r *targetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root(IDKey), path.Root(IDKey), req, resp)
+	if resp.Diagnostics.HasError() || r.targetType == "" {
+		return
 	}
-	if err := d.Set(targetHostSourceIdsKey, raw["host_source_ids"]); err != nil {
-		return err
+	resp.Diagnostics.Append(r.checkImportedType(ctx, resp.State)...)
+}
+
+// targetModelGetter is satisfied by tfsdk.Config, tfsdk.Plan and tfsdk.State
+type targetModelGetter interface {
+	Get(context.Context, interface{}) diag.Diagnostics
+}
+
+// get returns the model of the target, the attributes boundary_target_tcp does
+// not have are null for it
+func (r *targetResource) get(ctx context.Context, from targetModelGetter) (targetResourceModel, diag.Diagnostics) {
+	m := targetResourceModel{
+		InjectedAppCredentialSourceIDs: types.SetNull(types.StringType),
+		EnableSessionRecording:         types.BoolNull(),
+		StorageBucketID:                types.StringNull(),
 	}
-	if err := d.Set(targetBrokeredCredentialSourceIdsKey, raw["brokered_credential_source_ids"]); err != nil {
-		return err
+	if r.targetType == targetTypeTcp {
+		return m, from.Get(ctx, &m.targetTcpResourceModel)
 	}
-	if err := d.Set(targetInjectedAppCredentialSourceIdsKey, raw["injected_application_credential_source_ids"]); err != nil {
-		return err
+	return m, from.Get(ctx, &m)
+}
+
+// set saves the model of the target and its identity
+func (r *targetResource) set(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, m *targetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.targetType == targetTypeTcp {
+		diags.Append(state.Set(ctx, &m.targetTcpResourceModel)...)
+	} else {
+		diags.Append(state.Set(ctx, m)...)
 	}
-	if err := d.Set(targetSessionMaxSecondsKey, raw["session_max_seconds"]); err != nil {
-		return err
+	diags.Append(identity.Set(ctx, targetResourceIdentityModel{ID: m.ID})...)
+	return diags
+}
+
+// targetTypeOf returns the type of the target, it is empty when not known yet
+func (r *targetResource) targetTypeOf(m *targetResourceModel) string {
+	if r.targetType != "" {
+		return r.targetType
 	}
-	if err := d.Set(targetSessionConnectionLimitKey, raw["session_connection_limit"]); err != nil {
-		return err
+	return m.Type.ValueString()
+}
+
+func (r *targetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config, diags := r.get(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if err := d.Set(targetWorkerFilterKey, raw["worker_filter"]); err != nil {
-		return err
+	resp.Diagnostics.Append(validateTargetSessionHandling(ctx, config.SessionHandling)...)
+	resp.Diagnostics.Append(validateTargetAttributes(r.targetTypeOf(&config), &config)...)
+}
+
+func (r *targetResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanVersionRequirements(r.md, r.typeName(), req)...)
+}
+
+// validateTargetAttributes checks the values and the combinations of
+// attributes that are valid for a target of the given type, so that they are
+// rejected during plan rather than by the controller halfway through an
+// apply. The values that are not known yet are not checked.
+func validateTargetAttributes(typeStr string, m *targetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if port, ok := knownInt64(m.DefaultPort); ok && (port <= 0 || port > math.MaxUint16) {
+		diags.AddAttributeError(path.Root(targetDefaultPortKey), "Invalid Attribute Value",
+			fmt.Sprintf("expected %q to be a valid port number, got: %d", targetDefaultPortKey, port))
 	}
-	if err := d.Set(targetWorkerEgressFilterKey, raw["egress_worker_filter"]); err != nil {
-		return err
+	if port, ok := knownInt64(m.DefaultClientPort); ok && (port <= 0 || port > math.MaxUint16) {
+		diags.AddAttributeError(path.Root(targetDefaultClientPortKey), "Invalid Attribute Value",
+			fmt.Sprintf("expected %q to be a valid port number, got: %d", targetDefaultClientPortKey, port))
 	}
-	if err := d.Set(targetWorkerIngressFilterKey, raw["ingress_worker_filter"]); err != nil {
-		return err
+	if v, ok := knownInt64(m.SessionMaxSeconds); ok && v < 1 {
+		diags.AddAttributeError(path.Root(targetSessionMaxSecondsKey), "Invalid Attribute Value",
+			fmt.Sprintf("expected %q to be at least (1), got %d", targetSessionMaxSecondsKey, v))
 	}
-	if err := d.Set(targetAddressKey, raw["address"]); err != nil {
-		return err
+	if v, ok := knownInt64(m.SessionConnectionLimit); ok && v != -1 && v < 1 {
+		diags.AddAttributeError(path.Root(targetSessionConnectionLimitKey), "Invalid Attribute Value",
+			fmt.Sprintf("expected %q to be -1 or at least (1), got %d", targetSessionConnectionLimitKey, v))
 	}
 
-	typeStr := raw["type"].(string)
+	injectedCreds := !m.InjectedAppCredentialSourceIDs.IsNull() && !m.InjectedAppCredentialSourceIDs.IsUnknown() &&
+		len(m.InjectedAppCredentialSourceIDs.Elements()) > 0
+	enableSessionRecording := m.EnableSessionRecording.ValueBool()
+	storageBucketIdSet := !m.StorageBucketID.IsNull() && m.StorageBucketID.ValueString() != ""
 
 	switch typeStr {
-	case targetTypeTcp, targetTypeSsh, targetTypeRdp:
-		if attrsVal, ok := raw["attributes"]; ok {
-			attrs := attrsVal.(map[string]interface{})
-			if defPort, ok := attrs[targetDefaultPortKey].(json.Number); ok {
-				defPortInt, _ := defPort.Int64()
-				if err := d.Set(targetDefaultPortKey, int(defPortInt)); err != nil {
-					return err
-				}
-			}
-			if defCliPort, ok := attrs[targetDefaultClientPortKey].(json.Number); ok {
-				defCliPortInt, _ := defCliPort.Int64()
-				if err := d.Set(targetDefaultClientPortKey, int(defCliPortInt)); err != nil {
-					return err
-				}
-			}
-
-			// Session recording features (SSH and RDP)
-			if typeStr == targetTypeSsh || typeStr == targetTypeRdp {
-				if sessionRecordingVal, ok := attrs[targetEnableSessionRecordingKey].(bool); ok {
-					if err := d.Set(targetEnableSessionRecordingKey, sessionRecordingVal); err != nil {
-						return err
-					}
-				}
-				if targetStorageBucketIdVal, ok := attrs[targetStorageBucketIdKey]; ok {
-					if err := d.Set(targetStorageBucketIdKey, targetStorageBucketIdVal); err != nil {
-						return err
-					}
-				}
-			}
-		}
+	case targetTypeTcp:
+		if injectedCreds {
+			diags.AddAttributeError(path.Root(targetInjectedAppCredentialSourceIdsKey), "Invalid Attribute Combination",
+				fmt.Sprintf("%q is not supported on tcp targets", targetInjectedAppCredentialSourceIdsKey))
+		}
+		if enableSessionRecording || storageBucketIdSet || m.StorageBucketID.IsUnknown() {
+			diags.AddAttributeError(path.Root(targetEnableSessionRecordingKey), "Invalid Attribute Combination",
+				fmt.Sprintf("%q and %q are only supported on ssh and rdp targets", targetEnableSessionRecordingKey, targetStorageBucketIdKey))
+		}
+	case targetTypeSsh, targetTypeRdp:
+		if enableSessionRecording && !storageBucketIdSet && !m.StorageBucketID.IsUnknown() {
+			diags.AddAttributeError(path.Root(targetStorageBucketIdKey), "Invalid Attribute Combination",
+				fmt.Sprintf("%q must be set when %q is true", targetStorageBucketIdKey, targetEnableSessionRecordingKey))
+		}
+	case "":
+		// the type is not known yet
+	default:
+		diags.AddAttributeError(path.Root(TypeKey), "Invalid Attribute Value",
+			fmt.Sprintf("expected %s to be one of %q, got %s", TypeKey, []string{targetTypeTcp, targetTypeSsh, targetTypeRdp}, typeStr))
 	}
 
-	d.SetId(raw["id"].(string))
-	return nil
-}
+	if !m.Address.IsNull() && !m.HostSourceIDs.IsNull() {
+		diags.AddAttributeError(path.Root(targetAddressKey), "Conflicting configuration arguments",
+			fmt.Sprintf("%q: conflicts with %s", targetAddressKey, targetHostSourceIdsKey))
+	}
 
-func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (errs diag.Diagnostics) {
-	md := meta.(*metaData)
+	return diags
+}
 
-	var scopeId string
-	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
-		scopeId = scopeIdVal.(string)
-	} else {
-		return diag.Errorf("no scope ID provided")
+// knownInt64 returns the value of v and whether it is known and not null
+func knownInt64(v types.Int64) (int64, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return 0, false
 	}
+	return v.ValueInt64(), true
+}
 
-	var typeStr string
-	if typeVal, ok := d.GetOk(TypeKey); ok {
-		typeStr = typeVal.(string)
-	} else {
-		return diag.Errorf("no type provided")
-	}
-	switch typeStr {
-	case targetTypeTcp, targetTypeSsh, targetTypeRdp:
+// setFromTargetResponseMap sets the model from the target returned by the
+// controller
+func setFromTargetResponseMap(ctx context.Context, m *targetResourceModel, raw map[string]interface{}) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	m.ID = types.StringValue(raw["id"].(string))
+	m.Name = stringFromResponseMap(raw, "name", m.Name)
+	m.Description = stringFromResponseMap(raw, "description", m.Description)
+	m.ScopeID = stringFromResponseMap(raw, "scope_id", m.ScopeID)
+	m.Type = stringFromResponseMap(raw, "type", m.Type)
+	m.HostSourceIDs, d = stringSetFromResponseMap(ctx, raw, "host_source_ids", m.HostSourceIDs)
+	diags.Append(d...)
+	m.BrokeredCredentialSourceIDs, d = stringSetFromResponseMap(ctx, raw, "brokered_credential_source_ids", m.BrokeredCredentialSourceIDs)
+	diags.Append(d...)
+	if raw["type"] != targetTypeTcp {
+		// tcp targets do not support injected application credentials, so
+		// boundary_target_tcp does not have the attribute at all
+		m.InjectedAppCredentialSourceIDs, d = stringSetFromResponseMap(ctx, raw, "injected_application_credential_source_ids", m.InjectedAppCredentialSourceIDs)
+		diags.Append(d...)
+	}
+	m.SessionMaxSeconds = int64FromResponseMap(raw, "session_max_seconds", m.SessionMaxSeconds)
+	m.SessionConnectionLimit = int64FromResponseMap(raw, "session_connection_limit", m.SessionConnectionLimit)
+	m.WorkerFilter = stringFromResponseMap(raw, "worker_filter", m.WorkerFilter)
+	m.EgressWorkerFilter = stringFromResponseMap(raw, "egress_worker_filter", m.EgressWorkerFilter)
+	m.IngressWorkerFilter = stringFromResponseMap(raw, "ingress_worker_filter", m.IngressWorkerFilter)
+	m.Address = stringFromResponseMap(raw, "address", m.Address)
+
+	attrs, _ := raw["attributes"].(map[string]interface{})
+	m.DefaultPort = int64FromResponseMap(attrs, targetDefaultPortKey, m.DefaultPort)
+	m.DefaultClientPort = int64FromResponseMap(attrs, targetDefaultClientPortKey, m.DefaultClientPort)
+	// Session recording features (SSH and RDP)
+	if raw["type"] == targetTypeSsh || raw["type"] == targetTypeRdp {
+		m.EnableSessionRecording = boolFromResponseMap(attrs, targetEnableSessionRecordingKey, m.EnableSessionRecording)
+		m.StorageBucketID = stringFromResponseMap(attrs, targetStorageBucketIdKey, m.StorageBucketID)
+	}
+	return diags
+}
+
+// targetDefaultPortOption returns the option setting the default port of a
+// target of the given type, it unsets it when port is null
+func targetDefaultPortOption(typeStr string, port types.Int64) targets.Option {
+	switch {
+	case typeStr == targetTypeSsh && port.IsNull():
+		return targets.DefaultSshTargetDefaultPort()
+	case typeStr == targetTypeSsh:
+		return targets.WithSshTargetDefaultPort(uint32(port.ValueInt64()))
+	case typeStr == targetTypeRdp && port.IsNull():
+		return targets.DefaultRdpTargetDefaultPort()
+	case typeStr == targetTypeRdp:
+		return targets.WithRdpTargetDefaultPort(uint32(port.ValueInt64()))
+	case port.IsNull():
+		return targets.DefaultTcpTargetDefaultPort()
 	default:
-		return diag.Errorf("invalid type provided")
+		return targets.WithTcpTargetDefaultPort(uint32(port.ValueInt64()))
 	}
+}
 
-	var opts []targets.Option
-	nameVal, ok := d.GetOk(NameKey)
-	if ok {
-		nameStr := nameVal.(string)
-		opts = append(opts, targets.WithName(nameStr))
+// targetDefaultClientPortOption returns the option setting the default client
+// port of a target of the given type, it unsets it when port is null
+func targetDefaultClientPortOption(typeStr string, port types.Int64) targets.Option {
+	switch {
+	case typeStr == targetTypeSsh && port.IsNull():
+		return targets.DefaultSshTargetDefaultClientPort()
+	case typeStr == targetTypeSsh:
+		return targets.WithSshTargetDefaultClientPort(uint32(port.ValueInt64()))
+	case typeStr == targetTypeRdp && port.IsNull():
+		return targets.DefaultRdpTargetDefaultClientPort()
+	case typeStr == targetTypeRdp:
+		return targets.WithRdpTargetDefaultClientPort(uint32(port.ValueInt64()))
+	case port.IsNull():
+		return targets.DefaultTcpTargetDefaultClientPort()
+	default:
+		return targets.WithTcpTargetDefaultClientPort(uint32(port.ValueInt64()))
 	}
+}
 
-	descVal, ok := d.GetOk(DescriptionKey)
-	if ok {
-		descStr := descVal.(string)
-		opts = append(opts, targets.WithDescription(descStr))
+// targetSessionRecordingOptions returns the options setting the session
+// recording of a target, none are returned for tcp targets which do not
+// support it
+func targetSessionRecordingOptions(typeStr string, enable types.Bool, storageBucketId types.String) []targets.Option {
+	switch typeStr {
+	case targetTypeSsh:
+		opts := []targets.Option{targets.WithSshTargetEnableSessionRecording(enable.ValueBool())}
+		if storageBucketId.IsNull() {
+			return append(opts, targets.DefaultSshTargetStorageBucketId())
+		}
+		return append(opts, targets.WithSshTargetStorageBucketId(storageBucketId.ValueString()))
+	case targetTypeRdp:
+		opts := []targets.Option{targets.WithRdpTargetEnableSessionRecording(enable.ValueBool())}
+		if storageBucketId.IsNull() {
+			return append(opts, targets.DefaultRdpTargetStorageBucketId())
+		}
+		return append(opts, targets.WithRdpTargetStorageBucketId(storageBucketId.ValueString()))
+	default:
+		return nil
 	}
+}
 
-	defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-	if ok {
-		defaultPortInt := defaultPortVal.(int)
-		if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-			return diag.Errorf(`"default_port" must be a valid tcp port`)
-		}
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.WithTcpTargetDefaultPort(uint32(defaultPortInt)))
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
-		case targetTypeRdp:
-			opts = append(opts, targets.WithRdpTargetDefaultPort(uint32(defaultPortInt)))
-		}
+func (r *targetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, diags := r.get(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-	if ok {
-		defaultClientPortInt := defaultClientPortVal.(int)
-		if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-			return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-		}
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortInt)))
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortInt)))
-		case targetTypeRdp:
-			opts = append(opts, targets.WithRdpTargetDefaultClientPort(uint32(defaultClientPortInt)))
-		}
+	timeout, diags := plan.Timeouts.Create(ctx, resourceTimeoutsFor(r.typeName()).create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
-	if ok {
-		enableSessionRecordingBool := enableSessionRecordingVal.(bool)
-		switch typeStr {
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetEnableSessionRecording(enableSessionRecordingBool))
-		case targetTypeRdp:
-			opts = append(opts, targets.WithRdpTargetEnableSessionRecording(enableSessionRecordingBool))
-		}
+	typeStr := r.targetTypeOf(&plan)
+	var opts []targets.Option
+	if !plan.Name.IsNull() {
+		opts = append(opts, targets.WithName(plan.Name.ValueString()))
 	}
-
-	storageBucketIdVal, ok := d.GetOk(targetStorageBucketIdKey)
-	if ok {
-		storageBucketIdStr := storageBucketIdVal.(string)
-		switch typeStr {
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetStorageBucketId(storageBucketIdStr))
-		case targetTypeRdp:
-			opts = append(opts, targets.WithRdpTargetStorageBucketId(storageBucketIdStr))
-		}
+	if !plan.Description.IsNull() {
+		opts = append(opts, targets.WithDescription(plan.Description.ValueString()))
 	}
-
-	sessionMaxSecondsVal, ok := d.GetOk(targetSessionMaxSecondsKey)
-	if ok {
-		sessionMaxSecondsInt := sessionMaxSecondsVal.(int)
-		if sessionMaxSecondsInt <= 0 {
-			return diag.Errorf(`"session_max_seconds" must be greater than zero`)
-		}
-		opts = append(opts, targets.WithSessionMaxSeconds(uint32(sessionMaxSecondsInt)))
+	if !plan.DefaultPort.IsNull() {
+		opts = append(opts, targetDefaultPortOption(typeStr, plan.DefaultPort))
 	}
-
-	sessionConnectionLimitVal, ok := d.GetOk(targetSessionConnectionLimitKey)
-	if ok {
-		sessionConnectionLimitInt := sessionConnectionLimitVal.(int)
-		if sessionConnectionLimitInt != -1 && sessionConnectionLimitInt <= 0 {
-			return diag.Errorf(`"session_connection_limit" must be -1 or greater than zero`)
-		}
-		opts = append(opts, targets.WithSessionConnectionLimit(int32(sessionConnectionLimitInt)))
+	if !plan.DefaultClientPort.IsNull() {
+		opts = append(opts, targetDefaultClientPortOption(typeStr, plan.DefaultClientPort))
 	}
-
-	var hostSourceIds []string
-	if hostSourceIdsVal, ok := d.GetOk(targetHostSourceIdsKey); ok {
-		list := hostSourceIdsVal.(*schema.Set).List()
-		hostSourceIds = make([]string, 0, len(list))
-		for _, i := range list {
-			hostSourceIds = append(hostSourceIds, i.(string))
-		}
+	if !plan.EnableSessionRecording.IsNull() || !plan.StorageBucketID.IsNull() {
+		opts = append(opts, targetSessionRecordingOptions(typeStr, plan.EnableSessionRecording, plan.StorageBucketID)...)
 	}
-
-	var brokeredCreds []string
-	if credentialSourceIdsVal, ok := d.GetOk(targetBrokeredCredentialSourceIdsKey); ok {
-		list := credentialSourceIdsVal.(*schema.Set).List()
-		brokeredCreds = make([]string, 0, len(list))
-		for _, i := range list {
-			brokeredCreds = append(brokeredCreds, i.(string))
-		}
+	if v, ok := knownInt64(plan.SessionMaxSeconds); ok {
+		opts = append(opts, targets.WithSessionMaxSeconds(uint32(v)))
 	}
-
-	var injectedCreds []string
-	if credentialSourceIdsVal, ok := d.GetOk(targetInjectedAppCredentialSourceIdsKey); ok {
-		list := credentialSourceIdsVal.(*schema.Set).List()
-		injectedCreds = make([]string, 0, len(list))
-		for _, i := range list {
-			injectedCreds = append(injectedCreds, i.(string))
-		}
+	if v, ok := knownInt64(plan.SessionConnectionLimit); ok {
+		opts = append(opts, targets.WithSessionConnectionLimit(int32(v)))
 	}
-
-	workerFilterVal, ok := d.GetOk(targetWorkerFilterKey)
-	if ok {
-		workerFilterStr := workerFilterVal.(string)
-		opts = append(opts, targets.WithWorkerFilter(workerFilterStr))
+	if !plan.WorkerFilter.IsNull() {
+		opts = append(opts, targets.WithWorkerFilter(plan.WorkerFilter.ValueString()))
 	}
-
-	workerEgressFilterVal, ok := d.GetOk(targetWorkerEgressFilterKey)
-	if ok {
-		workerEgressFilterStr := workerEgressFilterVal.(string)
-		opts = append(opts, targets.WithEgressWorkerFilter(workerEgressFilterStr))
+	if !plan.EgressWorkerFilter.IsNull() {
+		opts = append(opts, targets.WithEgressWorkerFilter(plan.EgressWorkerFilter.ValueString()))
 	}
-
-	workerIngressFilterVal, ok := d.GetOk(targetWorkerIngressFilterKey)
-	if ok {
-		workerIngressFilterStr := workerIngressFilterVal.(string)
-		opts = append(opts, targets.WithIngressWorkerFilter(workerIngressFilterStr))
+	if !plan.IngressWorkerFilter.IsNull() {
+		opts = append(opts, targets.WithIngressWorkerFilter(plan.IngressWorkerFilter.ValueString()))
+	}
+	if !plan.Address.IsNull() {
+		opts = append(opts, targets.WithAddress(plan.Address.ValueString()))
 	}
 
-	addrVal, ok := d.GetOk(targetAddressKey)
-	if ok {
-		addrStr := addrVal.(string)
-		opts = append(opts, targets.WithAddress(addrStr))
+	hostSourceIds, diags := stringsFromSet(ctx, plan.HostSourceIDs)
+	resp.Diagnostics.Append(diags...)
+	brokeredCreds, diags := stringsFromSet(ctx, plan.BrokeredCredentialSourceIDs)
+	resp.Diagnostics.Append(diags...)
+	injectedCreds, diags := stringsFromSet(ctx, plan.InjectedAppCredentialSourceIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	tc := targets.NewClient(md.client)
-	tcr, err := tc.Create(ctx, typeStr, scopeId, opts...)
+	tc := targets.NewClient(r.md.client)
+	tcr, err := tc.Create(ctx, typeStr, plan.ScopeID.ValueString(), opts...)
 	if err != nil {
-		return diag.Errorf("error creating target: %v", err)
+		resp.Diagnostics.AddError("Error creating target", err.Error())
+		return
 	}
-	if tcr == nil {
-		return diag.Errorf("target nil after create")
+	if tcr == nil || tcr.Item == nil {
+		resp.Diagnostics.AddError("Error creating target", "target nil after create")
+		return
 	}
 	apiResponse := tcr.GetResponse().Map
-	defer func() {
-		if err := setFromTargetResponseMap(d, apiResponse); err != nil {
-			errs = append(errs, diag.FromErr(err)...)
-		}
-	}()
-
 	version := tcr.Item.Version
-	if hostSourceIds != nil {
+
+	if len(hostSourceIds) > 0 {
 		tur, err := tc.SetHostSources(ctx, tcr.Item.Id, version, hostSourceIds)
-		if err != nil {
-			return diag.Errorf("error setting host sources on target: %v", err)
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddError("Error setting host sources on target", err.Error())
+		case tur == nil:
+			resp.Diagnostics.AddError("Error setting host sources on target", "nil target after setting host sources")
+		default:
+			apiResponse = tur.GetResponse().Map
+			version = tur.Item.Version
 		}
-		if tur == nil {
-			return diag.Errorf("nil target after setting host sources")
-		}
-		apiResponse = tur.GetResponse().Map
-		version = tur.Item.Version
 	}
 
 	var credOpts []targets.Option
-	if brokeredCreds != nil {
+	if len(brokeredCreds) > 0 {
 		credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(brokeredCreds))
 	}
-	if injectedCreds != nil {
+	if len(injectedCreds) > 0 {
 		credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(injectedCreds))
 	}
-	if len(credOpts) > 0 {
+	if len(credOpts) > 0 && !resp.Diagnostics.HasError() {
 		tur, err := tc.SetCredentialSources(ctx, tcr.Item.Id, version, credOpts...)
-		if err != nil {
-			return diag.Errorf("error setting credential sources on target: %v", err)
-		}
-		if tur == nil {
-			return diag.Errorf("nil target after setting credential sources")
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddError("Error setting credential sources on target", err.Error())
+		case tur == nil:
+			resp.Diagnostics.AddError("Error setting credential sources on target", "nil target after setting credential sources")
+		default:
+			apiResponse = tur.GetResponse().Map
 		}
-		apiResponse = tur.GetResponse().Map
 	}
 
-	return nil
+	// The target was created, it is saved even if setting its host or
+	// credential sources failed so it is not left behind
+	resp.Diagnostics.Append(setFromTargetResponseMap(ctx, &plan, apiResponse)...)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, resp.Identity, &plan)...)
 }
 
-func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	tc := targets.NewClient(md.client)
+func (r *targetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, diags := r.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, resourceTimeoutsFor(r.typeName()).read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	trr, err := tc.Read(ctx, d.Id())
+	trr, err := targets.NewClient(r.md.client).Read(ctx, state.ID.ValueString())
 	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
-			d.SetId("")
-			return nil
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		return diag.Errorf("error reading target: %v", err)
+		resp.Diagnostics.AddError("Error reading target", err.Error())
+		return
 	}
 	if trr == nil {
-		return diag.Errorf("target nil after read")
+		resp.Diagnostics.AddError("Error reading target", "target nil after read")
+		return
 	}
 
-	if err := setFromTargetResponseMap(d, trr.GetResponse().Map); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(setFromTargetResponseMap(ctx, &state, trr.GetResponse().Map)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	return nil
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, resp.Identity, &state)...)
 }
 
-func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	tc := targets.NewClient(md.client)
+// Update saves the state of the attributes that were updated, the ones that
+// could not be keep their prior state so they are updated again next time
+func (r *targetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, diags := r.get(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	state, diags := r.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, resourceTimeoutsFor(r.typeName()).update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tc := targets.NewClient(r.md.client)
+	id := state.ID.ValueString()
+	typeStr := state.Type.ValueString()
+	updated := state
+	updated.Timeouts = plan.Timeouts
+	updated.SessionHandling = plan.SessionHandling
+	defer func() {
+		resp.Diagnostics.Append(r.set(ctx, &resp.State, resp.Identity, &updated)...)
+	}()
 
 	var opts []targets.Option
-
-	var typeStr string
-	if typeVal, ok := d.GetOk(TypeKey); ok {
-		typeStr = typeVal.(string)
-	}
-	switch typeStr {
-	case targetTypeTcp, targetTypeSsh, targetTypeRdp:
-	default:
-		return diag.Errorf("invalid type provided")
-	}
-
-	var name *string
-	if d.HasChange(NameKey) {
+	if !plan.Name.Equal(state.Name) {
 		opts = append(opts, targets.DefaultName())
-		nameVal, ok := d.GetOk(NameKey)
-		if ok {
-			nameStr := nameVal.(string)
-			name = &nameStr
-			opts = append(opts, targets.WithName(nameStr))
+		if !plan.Name.IsNull() {
+			opts = append(opts, targets.WithName(plan.Name.ValueString()))
 		}
 	}
-
-	var desc *string
-	if d.HasChange(DescriptionKey) {
+	if !plan.Description.Equal(state.Description) {
 		opts = append(opts, targets.DefaultDescription())
-		descVal, ok := d.GetOk(DescriptionKey)
-		if ok {
-			descStr := descVal.(string)
-			desc = &descStr
-			opts = append(opts, targets.WithDescription(descStr))
+		if !plan.Description.IsNull() {
+			opts = append(opts, targets.WithDescription(plan.Description.ValueString()))
 		}
 	}
-
-	var enableSessionRecording *bool
-	if d.HasChange(targetEnableSessionRecordingKey) {
-		switch typeStr {
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetEnableSessionRecording(false))
-			enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
-			if ok {
-				enableSessionRecordingBool := enableSessionRecordingVal.(bool)
-				enableSessionRecording = &enableSessionRecordingBool
-				opts = append(opts, targets.WithSshTargetEnableSessionRecording(enableSessionRecordingBool))
-			}
-		case targetTypeRdp:
-			opts = append(opts, targets.WithRdpTargetEnableSessionRecording(false))
-			enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
-			if ok {
-				enableSessionRecordingBool := enableSessionRecordingVal.(bool)
-				enableSessionRecording = &enableSessionRecordingBool
-				opts = append(opts, targets.WithRdpTargetEnableSessionRecording(enableSessionRecordingBool))
-			}
-		}
+	if !plan.DefaultPort.Equal(state.DefaultPort) {
+		opts = append(opts, targetDefaultPortOption(typeStr, plan.DefaultPort))
 	}
-
-	var storageBucket *string
-	if d.HasChange(targetStorageBucketIdKey) {
-		switch typeStr {
-		case targetTypeSsh:
-			opts = append(opts, targets.DefaultSshTargetStorageBucketId())
-			storageBucketVal, ok := d.GetOk(targetStorageBucketIdKey)
-			if ok {
-				storageBucketStr := storageBucketVal.(string)
-				storageBucket = &storageBucketStr
-				opts = append(opts, targets.WithSshTargetStorageBucketId(storageBucketStr))
-			}
-		case targetTypeRdp:
-			opts = append(opts, targets.DefaultRdpTargetStorageBucketId())
-			storageBucketVal, ok := d.GetOk(targetStorageBucketIdKey)
-			if ok {
-				storageBucketStr := storageBucketVal.(string)
-				storageBucket = &storageBucketStr
-				opts = append(opts, targets.WithRdpTargetStorageBucketId(storageBucketStr))
-			}
-		}
-	}
-
-	var defaultPort *int
-	if d.HasChange(targetDefaultPortKey) {
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.DefaultTcpTargetDefaultPort())
-			defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-			if ok {
-				defaultPortInt := defaultPortVal.(int)
-				if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_port" must be a valid tcp port`)
-				}
-				defaultPort = &defaultPortInt
-				opts = append(opts, targets.WithTcpTargetDefaultPort(uint32(defaultPortInt)))
-			}
-
-		case targetTypeSsh:
-			opts = append(opts, targets.DefaultSshTargetDefaultPort())
-			defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-			if ok {
-				defaultPortInt := defaultPortVal.(int)
-				if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_port" must be a valid tcp port`)
-				}
-				defaultPort = &defaultPortInt
-				opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
-			}
-
-		case targetTypeRdp:
-			opts = append(opts, targets.DefaultRdpTargetDefaultPort())
-			defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-			if ok {
-				defaultPortInt := defaultPortVal.(int)
-				if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_port" must be a valid tcp port`)
-				}
-				defaultPort = &defaultPortInt
-				opts = append(opts, targets.WithRdpTargetDefaultPort(uint32(defaultPortInt)))
-			}
-		}
+	if !plan.DefaultClientPort.Equal(state.DefaultClientPort) {
+		opts = append(opts, targetDefaultClientPortOption(typeStr, plan.DefaultClientPort))
 	}
-
-	var defaultClientPort *int
-	if d.HasChange(targetDefaultClientPortKey) {
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.DefaultTcpTargetDefaultClientPort())
-			defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-			if ok {
-				defaultClientPortInt := defaultClientPortVal.(int)
-				if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-				}
-				defaultClientPort = &defaultClientPortInt
-				opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortInt)))
-			}
-
-		case targetTypeSsh:
-			opts = append(opts, targets.DefaultSshTargetDefaultClientPort())
-			defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-			if ok {
-				defaultClientPortInt := defaultClientPortVal.(int)
-				if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-				}
-				defaultClientPort = &defaultClientPortInt
-				opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortInt)))
-			}
-
-		case targetTypeRdp:
-			opts = append(opts, targets.DefaultRdpTargetDefaultClientPort())
-			defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-			if ok {
-				defaultClientPortInt := defaultClientPortVal.(int)
-				if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-				}
-				defaultClientPort = &defaultClientPortInt
-				opts = append(opts, targets.WithRdpTargetDefaultClientPort(uint32(defaultClientPortInt)))
-			}
-		}
+	if !plan.EnableSessionRecording.Equal(state.EnableSessionRecording) || !plan.StorageBucketID.Equal(state.StorageBucketID) {
+		opts = append(opts, targetSessionRecordingOptions(typeStr, plan.EnableSessionRecording, plan.StorageBucketID)...)
 	}
-
-	var sessionMaxSeconds *int
-	if d.HasChange(targetSessionMaxSecondsKey) {
+	if !plan.SessionMaxSeconds.Equal(state.SessionMaxSeconds) {
 		opts = append(opts, targets.DefaultSessionMaxSeconds())
-		sessionMaxSecondsVal, ok := d.GetOk(targetSessionMaxSecondsKey)
-		if ok {
-			sessionMaxSecondsInt := sessionMaxSecondsVal.(int)
-			if sessionMaxSecondsInt <= 0 {
-				return diag.Errorf(`"session_max_seconds" must be greater than zero`)
-			}
-			sessionMaxSeconds = &sessionMaxSecondsInt
-			opts = append(opts, targets.WithSessionMaxSeconds(uint32(sessionMaxSecondsInt)))
+		if v, ok := knownInt64(plan.SessionMaxSeconds); ok {
+			opts = append(opts, targets.WithSessionMaxSeconds(uint32(v)))
 		}
 	}
-
-	var sessionConnectionLimit *int
-	if d.HasChange(targetSessionConnectionLimitKey) {
+	if !plan.SessionConnectionLimit.Equal(state.SessionConnectionLimit) {
 		opts = append(opts, targets.DefaultSessionConnectionLimit())
-		sessionConnectionLimitVal, ok := d.GetOk(targetSessionConnectionLimitKey)
-		if ok {
-			sessionConnectionLimitInt := sessionConnectionLimitVal.(int)
-			if sessionConnectionLimitInt != -1 && sessionConnectionLimitInt <= 0 {
-				return diag.Errorf(`"session_connection_limit" must be -1 or greater than zero`)
-			}
-			sessionConnectionLimit = &sessionConnectionLimitInt
-			opts = append(opts, targets.WithSessionConnectionLimit(int32(sessionConnectionLimitInt)))
+		if v, ok := knownInt64(plan.SessionConnectionLimit); ok {
+			opts = append(opts, targets.WithSessionConnectionLimit(int32(v)))
 		}
 	}
-
-	var workerFilter *string
-	if d.HasChange(targetWorkerFilterKey) {
+	if !plan.WorkerFilter.Equal(state.WorkerFilter) {
 		opts = append(opts, targets.DefaultWorkerFilter())
-		workerFilterVal, ok := d.GetOk(targetWorkerFilterKey)
-		if ok {
-			workerFilterStr := workerFilterVal.(string)
-			workerFilter = &workerFilterStr
-			opts = append(opts, targets.WithWorkerFilter(workerFilterStr))
+		if !plan.WorkerFilter.IsNull() {
+			opts = append(opts, targets.WithWorkerFilter(plan.WorkerFilter.ValueString()))
 		}
 	}
-
-	var workerEgressFilter *string
-	if d.HasChange(targetWorkerEgressFilterKey) {
+	if !plan.EgressWorkerFilter.Equal(state.EgressWorkerFilter) {
 		opts = append(opts, targets.DefaultEgressWorkerFilter())
-		workerEgressFilterVal, ok := d.GetOk(targetWorkerEgressFilterKey)
-		if ok {
-			workerEgressFilterStr := workerEgressFilterVal.(string)
-			workerEgressFilter = &workerEgressFilterStr
-			opts = append(opts, targets.WithEgressWorkerFilter(workerEgressFilterStr))
+		if !plan.EgressWorkerFilter.IsNull() {
+			opts = append(opts, targets.WithEgressWorkerFilter(plan.EgressWorkerFilter.ValueString()))
 		}
 	}
-
-	var workerIngressFilter *string
-	if d.HasChange(targetWorkerIngressFilterKey) {
+	if !plan.IngressWorkerFilter.Equal(state.IngressWorkerFilter) {
 		opts = append(opts, targets.DefaultIngressWorkerFilter())
-		workerIngressFilterVal, ok := d.GetOk(targetWorkerIngressFilterKey)
-		if ok {
-			workerIngressFilterStr := workerIngressFilterVal.(string)
-			workerIngressFilter = &workerIngressFilterStr
-			opts = append(opts, targets.WithIngressWorkerFilter(workerIngressFilterStr))
+		if !plan.IngressWorkerFilter.IsNull() {
+			opts = append(opts, targets.WithIngressWorkerFilter(plan.IngressWorkerFilter.ValueString()))
 		}
 	}
-
-	var addr *string
-	if d.HasChange(targetAddressKey) {
+	if !plan.Address.Equal(state.Address) {
 		opts = append(opts, targets.DefaultAddress())
-		addrVal, ok := d.GetOk(targetAddressKey)
-		if ok {
-			addrStr := addrVal.(string)
-			addr = &addrStr
-			opts = append(opts, targets.WithAddress(addrStr))
+		if !plan.Address.IsNull() {
+			opts = append(opts, targets.WithAddress(plan.Address.ValueString()))
 		}
 	}
 
-	if len(opts) > 0 {
-		opts = append(opts, targets.WithAutomaticVersioning(true))
-		_, err := tc.Update(ctx, d.Id(), 0, opts...)
-		if err != nil {
-			return diag.Errorf("error updating target: %v", err)
+	// host_source_ids and address change where new and existing sessions
+	// connect to, so active sessions are handled before they are updated.
+	// They are canceled once the target is updated, so they are kept when
+	// the update fails.
+	var activeSessions []*sessions.Session
+	if !plan.HostSourceIDs.Equal(state.HostSourceIDs) || !plan.Address.Equal(state.Address) {
+		var err error
+		if activeSessions, err = drainTargetSessions(ctx, r.md, &plan); err != nil {
+			resp.Diagnostics.AddError("Error handling the sessions of target", err.Error())
+			return
 		}
 	}
 
-	if d.HasChange(NameKey) {
-		if err := d.Set(NameKey, name); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(DescriptionKey) {
-		if err := d.Set(DescriptionKey, desc); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetDefaultPortKey) {
-		if err := d.Set(targetDefaultPortKey, defaultPort); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetDefaultClientPortKey) {
-		if err := d.Set(targetDefaultClientPortKey, defaultClientPort); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetSessionMaxSecondsKey) {
-		if err := d.Set(targetSessionMaxSecondsKey, sessionMaxSeconds); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetSessionConnectionLimitKey) {
-		if err := d.Set(targetSessionConnectionLimitKey, sessionConnectionLimit); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetWorkerFilterKey) {
-		if err := d.Set(targetWorkerFilterKey, workerFilter); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetWorkerEgressFilterKey) {
-		if err := d.Set(targetWorkerEgressFilterKey, workerEgressFilter); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetWorkerIngressFilterKey) {
-		if err := d.Set(targetWorkerIngressFilterKey, workerIngressFilter); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetAddressKey) {
-		if err := d.Set(targetAddressKey, addr); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetEnableSessionRecordingKey) {
-		if err := d.Set(targetEnableSessionRecordingKey, enableSessionRecording); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if d.HasChange(targetStorageBucketIdKey) {
-		if err := d.Set(targetStorageBucketIdKey, storageBucket); err != nil {
-			return diag.FromErr(err)
+	if len(opts) > 0 {
+		opts = append(opts, targets.WithAutomaticVersioning(true))
+		_, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+			return tc.Update(ctx, id, 0, opts...)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating target", err.Error())
+			return
+		}
+		updated.Name = plan.Name
+		updated.Description = plan.Description
+		updated.DefaultPort = plan.DefaultPort
+		updated.DefaultClientPort = plan.DefaultClientPort
+		updated.EnableSessionRecording = plan.EnableSessionRecording
+		updated.StorageBucketID = plan.StorageBucketID
+		updated.SessionMaxSeconds = plan.SessionMaxSeconds
+		updated.SessionConnectionLimit = plan.SessionConnectionLimit
+		updated.WorkerFilter = plan.WorkerFilter
+		updated.EgressWorkerFilter = plan.EgressWorkerFilter
+		updated.IngressWorkerFilter = plan.IngressWorkerFilter
+		updated.Address = plan.Address
+	}
+
+	if !plan.HostSourceIDs.Equal(state.HostSourceIDs) {
+		hostSourceIds, diags := stringsFromSet(ctx, plan.HostSourceIDs)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		_, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+			return tc.SetHostSources(ctx, id, 0, hostSourceIds, targets.WithAutomaticVersioning(true))
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating host sources in target", err.Error())
+			return
 		}
+		updated.HostSourceIDs = plan.HostSourceIDs
 	}
 
-	// The above call may not actually happen, so we use d.Id() and automatic
-	// versioning here
-	if d.HasChange(targetHostSourceIdsKey) {
-		var hostSourceIds []string
-		if hostSourceIdsVal, ok := d.GetOk(targetHostSourceIdsKey); ok {
-			hostSources := hostSourceIdsVal.(*schema.Set).List()
-			for _, hostSource := range hostSources {
-				hostSourceIds = append(hostSourceIds, hostSource.(string))
-			}
-		}
-		_, err := tc.SetHostSources(ctx, d.Id(), 0, hostSourceIds, targets.WithAutomaticVersioning(true))
-		if err != nil {
-			return diag.Errorf("error updating host sources in target: %v", err)
-		}
-		if err := d.Set(targetHostSourceIdsKey, hostSourceIds); err != nil {
-			return diag.FromErr(err)
-		}
+	if err := cancelTargetSessions(ctx, r.md, activeSessions); err != nil {
+		resp.Diagnostics.AddError("Error handling the sessions of target", err.Error())
+		return
 	}
 
 	// if any of the credential types are changed, then all credential ids must be gathered
 	// because the SetCredentialSources function will remove ids that are not present.
-	if d.HasChange(targetBrokeredCredentialSourceIdsKey) || d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
+	if !plan.BrokeredCredentialSourceIDs.Equal(state.BrokeredCredentialSourceIDs) ||
+		!plan.InjectedAppCredentialSourceIDs.Equal(state.InjectedAppCredentialSourceIDs) {
 		credOpts := []targets.Option{
 			targets.WithAutomaticVersioning(true),
 		}
-
-		if credentialSourceIdsVal, ok := d.GetOk(targetBrokeredCredentialSourceIdsKey); ok {
-			var credentialSourceIds []string
-			credSourceIds := credentialSourceIdsVal.(*schema.Set).List()
-			for _, credSourceId := range credSourceIds {
-				credentialSourceIds = append(credentialSourceIds, credSourceId.(string))
-			}
-			credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(credentialSourceIds))
-		}
-
-		if credentialSourceIdsVal, ok := d.GetOk(targetInjectedAppCredentialSourceIdsKey); ok {
-			var credentialSourceIds []string
-			credSourceIds := credentialSourceIdsVal.(*schema.Set).List()
-			for _, credSourceId := range credSourceIds {
-				credentialSourceIds = append(credentialSourceIds, credSourceId.(string))
-			}
-			credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(credentialSourceIds))
+		brokeredCreds, diags := stringsFromSet(ctx, plan.BrokeredCredentialSourceIDs)
+		resp.Diagnostics.Append(diags...)
+		injectedCreds, diags := stringsFromSet(ctx, plan.InjectedAppCredentialSourceIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-
-		result, err := tc.SetCredentialSources(ctx, d.Id(), 0, credOpts...)
-		if err != nil {
-			return diag.Errorf("error updating credential sources in target: %v", err)
+		if len(brokeredCreds) > 0 {
+			credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(brokeredCreds))
 		}
-
-		if d.HasChange(targetBrokeredCredentialSourceIdsKey) {
-			if err := d.Set(targetBrokeredCredentialSourceIdsKey, result.Item.BrokeredCredentialSourceIds); err != nil {
-				return diag.FromErr(err)
-			}
+		if len(injectedCreds) > 0 {
+			credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(injectedCreds))
 		}
 
-		if d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
-			if err := d.Set(targetInjectedAppCredentialSourceIdsKey, result.Item.InjectedApplicationCredentialSourceIds); err != nil {
-				return diag.FromErr(err)
-			}
+		_, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+			return tc.SetCredentialSources(ctx, id, 0, credOpts...)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating credential sources in target", err.Error())
+			return
 		}
+		updated.BrokeredCredentialSourceIDs = plan.BrokeredCredentialSourceIDs
+		updated.InjectedAppCredentialSourceIDs = plan.InjectedAppCredentialSourceIDs
 	}
-
-	return nil
 }
 
-func resourceTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	tc := targets.NewClient(md.client)
+func (r *targetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state, diags := r.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	_, err := tc.Delete(ctx, d.Id())
-	if err != nil {
-		return diag.Errorf("error deleting target: %s", err.Error())
+	timeout, diags := state.Timeouts.Delete(ctx, resourceTimeoutsFor(r.typeName()).delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	return nil
+	if err := handleTargetSessions(ctx, r.md, &state); err != nil {
+		resp.Diagnostics.AddError("Error handling the sessions of target", err.Error())
+		return
+	}
+
+	_, err := targets.NewClient(r.md.client).Delete(ctx, state.ID.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting target", err.Error())
+	}
 }
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	targetCredentialSourceIdKey      = "credential_source_id"
+	targetCredentialSourcePurposeKey = "purpose"
+
+	targetCredentialPurposeBrokered            = "brokered"
+	targetCredentialPurposeInjectedApplication = "injected_application"
+)
+
+func resourceTargetCredentialSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target credential source resource allows you to attach a single credential source to a Boundary target. " +
+			"Targets that have credential sources attached this way should not set `brokered_credential_source_ids` or " +
+			"`injected_application_credential_source_ids`, or should ignore changes to them with a `lifecycle` block, " +
+			"otherwise the two resources will keep undoing each other's changes.",
+
+		CreateContext: resourceTargetCredentialSourceCreate,
+		ReadContext:   resourceTargetCredentialSourceRead,
+		DeleteContext: resourceTargetCredentialSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTargetCredentialSourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			TargetIdKey: {
+				Description:  "The ID of the target to attach the credential source to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			targetCredentialSourceIdKey: {
+				Description:  "The ID of the credential source (credential library or credential) to attach.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			targetCredentialSourcePurposeKey: {
+				Description: "The purpose of the credential source on the target, either `brokered` or `injected_application`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					targetCredentialPurposeBrokered,
+					targetCredentialPurposeInjectedApplication,
+				}, false),
+			},
+		},
+	}
+}
+
+// targetCredentialSourceOption returns the option used to add or remove a
+// credential source with the given purpose
+func targetCredentialSourceOption(purpose, credentialSourceId string) (targets.Option, error) {
+	switch purpose {
+	case targetCredentialPurposeBrokered:
+		return targets.WithBrokeredCredentialSourceIds([]string{credentialSourceId}), nil
+	case targetCredentialPurposeInjectedApplication:
+		return targets.WithInjectedApplicationCredentialSourceIds([]string{credentialSourceId}), nil
+	default:
+		return nil, fmt.Errorf("unknown credential source purpose %q", purpose)
+	}
+}
+
+// targetCredentialSourcePurpose returns the purpose with which the credential
+// source is attached to the target, or an empty string if it is not attached
+func targetCredentialSourcePurpose(t *targets.Target, credentialSourceId string) string {
+	switch {
+	case slices.Contains(t.BrokeredCredentialSourceIds, credentialSourceId):
+		return targetCredentialPurposeBrokered
+	case slices.Contains(t.InjectedApplicationCredentialSourceIds, credentialSourceId):
+		return targetCredentialPurposeInjectedApplication
+	default:
+		return ""
+	}
+}
+
+func resourceTargetCredentialSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+
+	opt, err := targetCredentialSourceOption(d.Get(targetCredentialSourcePurposeKey).(string), credentialSourceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+		return tc.AddCredentialSources(ctx, targetId, 0, opt, targets.WithAutomaticVersioning(true))
+	}); err != nil {
+		return diag.Errorf("error adding credential source to target: %v", err)
+	}
+
+	d.SetId(targetSourceId(targetId, credentialSourceId))
+	return nil
+}
+
+func resourceTargetCredentialSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the target is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading target: %v", err)
+	}
+	if trr == nil || trr.Item == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	purpose := targetCredentialSourcePurpose(trr.Item, credentialSourceId)
+	if purpose == "" {
+		// the credential source is no longer attached to the target, destroy this resource
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set(targetCredentialSourcePurposeKey, purpose); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTargetCredentialSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+
+	opt, err := targetCredentialSourceOption(d.Get(targetCredentialSourcePurposeKey).(string), credentialSourceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+		return tc.RemoveCredentialSources(ctx, targetId, 0, opt, targets.WithAutomaticVersioning(true))
+	}); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.Errorf("error removing credential source from target: %v", err)
+	}
+
+	return nil
+}
+
+func resourceTargetCredentialSourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId, credentialSourceId, err := parseTargetSourceId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading target: %w", err)
+	}
+	if trr == nil || trr.Item == nil {
+		return nil, fmt.Errorf("target nil after read")
+	}
+
+	// The purpose is part of the configuration but not of the import ID, so
+	// it is looked up from the target
+	purpose := targetCredentialSourcePurpose(trr.Item, credentialSourceId)
+	if purpose == "" {
+		return nil, fmt.Errorf("credential source %q is not attached to target %q", credentialSourceId, targetId)
+	}
+
+	if err := d.Set(TargetIdKey, targetId); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetCredentialSourceIdKey, credentialSourceId); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetCredentialSourcePurposeKey, purpose); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
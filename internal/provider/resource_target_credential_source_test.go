@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const targetCredentialSourceResc = "boundary_target_credential_source.foo"
+
+var (
+	targetCredentialSourceFoo = `
+resource "boundary_target_credential_source" "foo" {
+	target_id            = boundary_target.foo.id
+	credential_source_id = boundary_credential_library_vault.foo.id
+	purpose              = "brokered"
+}`
+
+	targetCredentialSourceBar = `
+resource "boundary_target_credential_source" "foo" {
+	target_id            = boundary_target.foo.id
+	credential_source_id = boundary_credential_library_vault.foo.id
+	purpose              = "brokered"
+}
+
+resource "boundary_target_credential_source" "bar" {
+	target_id            = boundary_target.foo.id
+	credential_source_id = boundary_credential_library_vault.bar.id
+	purpose              = "brokered"
+}`
+)
+
+func TestAccTargetCredentialSource(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// attach one credential source
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, targetWithoutSources, targetCredentialSourceFoo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(targetCredentialSourceResc, TargetIdKey, "boundary_target.foo", IDKey),
+					resource.TestCheckResourceAttrPair(targetCredentialSourceResc, targetCredentialSourceIdKey, "boundary_credential_library_vault.foo", IDKey),
+					resource.TestCheckResourceAttr(targetCredentialSourceResc, targetCredentialSourcePurposeKey, targetCredentialPurposeBrokered),
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.foo", []string{"boundary_credential_library_vault.foo"}),
+				),
+			},
+			importStep(targetCredentialSourceResc),
+			{
+				// attach a second credential source without touching the first
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, targetWithoutSources, targetCredentialSourceBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.foo", []string{"boundary_credential_library_vault.foo", "boundary_credential_library_vault.bar"}),
+				),
+			},
+			{
+				// detach both credential sources
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, targetWithoutSources),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.foo", []string{}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	targetHostSourceIdKey = "host_source_id"
+)
+
+func resourceTargetHostSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target host source resource allows you to attach a single host source to a Boundary target. " +
+			"Targets that have host sources attached this way should not set `host_source_ids`, or should ignore changes " +
+			"to it with a `lifecycle` block, otherwise the two resources will keep undoing each other's changes.",
+
+		CreateContext: resourceTargetHostSourceCreate,
+		ReadContext:   resourceTargetHostSourceRead,
+		DeleteContext: resourceTargetHostSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTargetHostSourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			TargetIdKey: {
+				Description:  "The ID of the target to attach the host source to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			targetHostSourceIdKey: {
+				Description:  "The ID of the host source (host set) to attach.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceTargetHostSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	hostSourceId := d.Get(targetHostSourceIdKey).(string)
+
+	if _, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+		return tc.AddHostSources(ctx, targetId, 0, []string{hostSourceId}, targets.WithAutomaticVersioning(true))
+	}); err != nil {
+		return diag.Errorf("error adding host source to target: %v", err)
+	}
+
+	d.SetId(targetSourceId(targetId, hostSourceId))
+	return nil
+}
+
+func resourceTargetHostSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	hostSourceId := d.Get(targetHostSourceIdKey).(string)
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the target is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading target: %v", err)
+	}
+	if trr == nil || trr.Item == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	if !slices.Contains(trr.Item.HostSourceIds, hostSourceId) {
+		// the host source is no longer attached to the target, destroy this resource
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceTargetHostSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(TargetIdKey).(string)
+	hostSourceId := d.Get(targetHostSourceIdKey).(string)
+
+	if _, err := retryOnVersionConflict(ctx, func() (*targets.TargetUpdateResult, error) {
+		return tc.RemoveHostSources(ctx, targetId, 0, []string{hostSourceId}, targets.WithAutomaticVersioning(true))
+	}); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.Errorf("error removing host source from target: %v", err)
+	}
+
+	return nil
+}
+
+func resourceTargetHostSourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	targetId, hostSourceId, err := parseTargetSourceId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(TargetIdKey, targetId); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetHostSourceIdKey, hostSourceId); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// targetSourceId returns the ID of a target host source or credential source
+// attachment
+func targetSourceId(targetId, sourceId string) string {
+	return fmt.Sprintf("%s:%s", targetId, sourceId)
+}
+
+// parseTargetSourceId splits an ID returned by targetSourceId back into the
+// target ID and source ID
+func parseTargetSourceId(id string) (string, string, error) {
+	targetId, sourceId, ok := strings.Cut(id, ":")
+	if !ok || targetId == "" || sourceId == "" {
+		return "", "", fmt.Errorf("unexpected ID %q, expected <target_id>:<source_id>", id)
+	}
+	return targetId, sourceId, nil
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const targetHostSourceResc = "boundary_target_host_source.foo"
+
+var (
+	targetWithoutSources = `
+resource "boundary_target" "foo" {
+	name         = "test"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	lifecycle {
+		ignore_changes = [
+			host_source_ids,
+			brokered_credential_source_ids,
+			injected_application_credential_source_ids,
+		]
+	}
+}`
+
+	targetHostSourceFoo = `
+resource "boundary_target_host_source" "foo" {
+	target_id      = boundary_target.foo.id
+	host_source_id = boundary_host_set.foo.id
+}`
+
+	targetHostSourceBar = `
+resource "boundary_target_host_source" "foo" {
+	target_id      = boundary_target.foo.id
+	host_source_id = boundary_host_set.foo.id
+}
+
+resource "boundary_target_host_source" "bar" {
+	target_id      = boundary_target.foo.id
+	host_source_id = boundary_host_set.bar.id
+}`
+)
+
+func TestAccTargetHostSource(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// attach one host source
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, targetWithoutSources, targetHostSourceFoo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(targetHostSourceResc, TargetIdKey, "boundary_target.foo", IDKey),
+					resource.TestCheckResourceAttrPair(targetHostSourceResc, targetHostSourceIdKey, "boundary_host_set.foo", IDKey),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", []string{"boundary_host_set.foo"}),
+				),
+			},
+			importStep(targetHostSourceResc),
+			{
+				// attach a second host source without touching the first
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, targetWithoutSources, targetHostSourceBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", []string{"boundary_host_set.foo", "boundary_host_set.bar"}),
+				),
+			},
+			{
+				// detach both host sources
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, targetWithoutSources),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", []string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestParseTargetSourceId(t *testing.T) {
+	t.Parallel()
+
+	targetId, sourceId, err := parseTargetSourceId(targetSourceId("ttcp_1234567890", "hsst_1234567890"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if targetId != "ttcp_1234567890" || sourceId != "hsst_1234567890" {
+		t.Fatalf("unexpected IDs: got %q and %q", targetId, sourceId)
+	}
+
+	for _, id := range []string{"", "ttcp_1234567890", "ttcp_1234567890:", ":hsst_1234567890"} {
+		if _, _, err := parseTargetSourceId(id); err == nil {
+			t.Fatalf("expected error for %q but got nil", id)
+		}
+	}
+}
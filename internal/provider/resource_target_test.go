@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/testing/controller"
 	"github.com/hashicorp/boundary/testing/vault"
@@ -219,6 +221,149 @@ resource "boundary_target" "foo" {
 }`, fooTargetDescription)
 )
 
+var (
+	fooTargetSessionHandling = `
+resource "boundary_target" "foo" {
+	name         = "test"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.1"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	session_handling {
+		mode = "fail_if_active"
+	}
+}`
+
+	fooTargetSessionHandlingUpdate = `
+resource "boundary_target" "foo" {
+	name         = "test"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.2"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	session_handling {
+		mode    = "wait_up_to"
+		timeout = "1m"
+	}
+}`
+)
+
+func fooTargetSessionHandlingConfig(address, mode, timeout string) string {
+	if timeout != "" {
+		timeout = fmt.Sprintf("timeout = %q", timeout)
+	}
+	return fmt.Sprintf(`
+resource "boundary_target" "foo" {
+	name         = "test"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "%s"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	session_handling {
+		mode = "%s"
+		%s
+	}
+}`, address, mode, timeout)
+}
+
+func TestAccTargetSessionHandling(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	var sessionId string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetSessionHandling),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionHandlingKey+".0."+targetSessionHandlingModeKey, targetSessionHandlingFailIfActive),
+				),
+			},
+			importStep("boundary_target.foo", targetSessionHandlingKey),
+			{
+				// the address changes without any active session to handle
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetSessionHandlingUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_target.foo", targetAddressKey, "127.0.0.2"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionHandlingKey+".0."+targetSessionHandlingModeKey, targetSessionHandlingWaitUpTo),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionHandlingKey+".0."+targetSessionHandlingTimeoutKey, "1m"),
+				),
+			},
+			{
+				// the target is left unchanged while it has an active session
+				PreConfig:   func() { sessionId = testAccAuthorizeTargetSession(t, provider, "boundary_target.foo") },
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetSessionHandlingConfig("127.0.0.3", targetSessionHandlingFailIfActive, "")),
+				ExpectError: regexp.MustCompile("active session"),
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetSessionHandlingConfig("127.0.0.3", targetSessionHandlingCancel, "")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_target.foo", targetAddressKey, "127.0.0.3"),
+					testAccCheckSessionCanceled(provider, &sessionId),
+				),
+			},
+			{
+				// the session outlives the wait and is canceled
+				PreConfig: func() { sessionId = testAccAuthorizeTargetSession(t, provider, "boundary_target.foo") },
+				Config:    testConfig(url, fooOrg, firstProjectFoo, fooTargetSessionHandlingConfig("127.0.0.1", targetSessionHandlingWaitUpTo, "1s")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_target.foo", targetAddressKey, "127.0.0.1"),
+					testAccCheckSessionCanceled(provider, &sessionId),
+				),
+			},
+		},
+	})
+}
+
+// testAccAuthorizeTargetSession authorizes a session on the target and
+// returns its ID. The test is skipped when the controller has no worker to
+// handle the session.
+func testAccAuthorizeTargetSession(t *testing.T, testProvider *schema.Provider, name string) string {
+	t.Helper()
+	md := testProvider.Meta().(*metaData)
+	ctx := context.Background()
+
+	tl, err := targets.NewClient(md.client).List(ctx, "global", targets.WithRecursive(true),
+		targets.WithFilter(fmt.Sprintf(`"/item/name" == %q`, "test")))
+	if err != nil || len(tl.Items) != 1 {
+		t.Fatalf("error finding target %s: %v", name, err)
+	}
+	sar, err := targets.NewClient(md.client).AuthorizeSession(ctx, tl.Items[0].Id)
+	if apiErr := api.AsServerError(err); apiErr != nil && strings.Contains(apiErr.Message, "No workers are available") {
+		t.Skipf("no worker is available to authorize a session: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("error authorizing a session on target %s: %v", name, err)
+	}
+	return sar.Item.SessionId
+}
+
+// testAccCheckSessionCanceled checks the session is canceling or terminated
+func testAccCheckSessionCanceled(testProvider *schema.Provider, sessionId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		md := testProvider.Meta().(*metaData)
+		sr, err := sessions.NewClient(md.client).Read(context.Background(), *sessionId)
+		if err != nil {
+			return fmt.Errorf("error reading session %q: %w", *sessionId, err)
+		}
+		if status := sr.Item.Status; status != sessionStatusCanceling && status != sessionStatusTerminated {
+			return fmt.Errorf("session %q is %q, expected it to be canceled", *sessionId, status)
+		}
+		return nil
+	}
+}
+
 func TestAccTarget(t *testing.T) {
 	tc := controller.NewTestController(t, tcConfig...)
 
@@ -237,9 +382,9 @@ func TestAccTarget(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -291,19 +436,9 @@ func TestAccTarget(t *testing.T) {
 			},
 			importStep("boundary_target.foo"),
 			{
-				// test updating state file when the target is created, but fails on associating an invalid injected credential source to a tcp target type
-				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetPartialSuccess),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
-					resource.TestCheckResourceAttr("boundary_target.foo", DescriptionKey, ""),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultPortKey, "80"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultClientPortKey, "8022"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "7000"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionConnectionLimitKey, "7"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetHostSourceIdsKey+".%", "0"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetInjectedAppCredentialSourceIdsKey+".%", "0"),
-				),
-				ExpectError: regexp.MustCompile("Unable to set credential sources in target: tcp.VetCredentialSources: tcp.Target only supports credential purpose"),
+				// injected credential sources on a tcp target are rejected during plan
+				Config:      testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetPartialSuccess),
+				ExpectError: regexp.MustCompile("is not supported on tcp targets"),
 			},
 			importStep("boundary_target.foo", targetInjectedAppCredentialSourceIdsKey),
 			{
@@ -335,9 +470,9 @@ func TestAccTargetWithAddress(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -406,9 +541,9 @@ func TestAccTargetWithAddress_MoveToHostSourceDirectly(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -444,9 +579,9 @@ func TestAccTargetWithAddress_MoveToHostSourceDirectly(t *testing.T) {
 func TestAccTargetWithAddress_HostSourceAndAddressConflict(t *testing.T) {
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create target with address and host source
@@ -457,6 +592,49 @@ func TestAccTargetWithAddress_HostSourceAndAddressConflict(t *testing.T) {
 	})
 }
 
+// TestAccTargetStateCompatibility checks the state written by the last release
+// serving boundary_target with the SDK is used as is by the framework version
+func TestAccTargetStateCompatibility(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+	token := tc.Token().Token
+
+	var provider *schema.Provider
+	factories := providerFactories(&provider)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the last release serving boundary_target with the SDK
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"boundary": {
+						Source:            "hashicorp/boundary",
+						VersionConstraint: "1.5.2",
+					},
+				},
+				Config: testConfigWithToken(url, token, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetSetHostSource),
+			},
+			{
+				// the framework version plans no change from the state of the release
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetSetHostSource),
+				PlanOnly:                 true,
+			},
+			{
+				// the state is refreshed and saved by the framework version
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetUnsetAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", nil),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultClientPortKey, "1022"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTargetResourceHostSource(testProvider *schema.Provider, name string, hostSources []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -604,7 +782,7 @@ func testAccCheckTargetResourceDestroy(t *testing.T, testProvider *schema.Provid
 
 		for _, rs := range s.RootModule().Resources {
 			switch rs.Type {
-			case "boundary_target":
+			case "boundary_target", "boundary_target_tcp", "boundary_target_ssh", "boundary_target_rdp":
 				tgts := targets.NewClient(md.client)
 
 				id := rs.Primary.ID
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newTargetTcpResource() resource.Resource {
+	return &targetResource{targetType: targetTypeTcp, displayName: "TCP"}
+}
+
+func newTargetSshResource() resource.Resource {
+	return &targetResource{targetType: targetTypeSsh, displayName: "SSH"}
+}
+
+func newTargetRdpResource() resource.Resource {
+	return &targetResource{targetType: targetTypeRdp, displayName: "RDP"}
+}
+
+// typedSchema fixes the schema of boundary_target to a single target type,
+// only the attributes that are valid for that type are kept
+func (r *targetResource) typedSchema(s *schema.Schema) {
+	s.MarkdownDescription = fmt.Sprintf("The %s target resource allows you to configure a Boundary %s target.", r.targetType, r.displayName)
+	s.Attributes[IDKey] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The ID of the %s target.", r.displayName),
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	s.Attributes[TypeKey] = schema.StringAttribute{
+		MarkdownDescription: "The target resource type.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	if r.targetType == targetTypeTcp {
+		delete(s.Attributes, targetInjectedAppCredentialSourceIdsKey)
+		delete(s.Attributes, targetEnableSessionRecordingKey)
+		delete(s.Attributes, targetStorageBucketIdKey)
+	}
+}
+
+// checkImportedType checks the imported target is of the type of the
+// resource
+func (r *targetResource) checkImportedType(ctx context.Context, state tfsdk.State) diag.Diagnostics {
+	var id types.String
+	diags := state.GetAttribute(ctx, path.Root(IDKey), &id)
+	if diags.HasError() {
+		return diags
+	}
+	trr, err := targets.NewClient(r.md.client).Read(ctx, id.ValueString())
+	if err != nil {
+		diags.AddError("Error reading target", err.Error())
+		return diags
+	}
+	if trr == nil || trr.Item == nil {
+		diags.AddError("Error reading target", "target nil after read")
+		return diags
+	}
+	if trr.Item.Type != r.targetType {
+		diags.AddError("Unexpected target type", fmt.Sprintf("target %q is of type %q, not %q", id.ValueString(), trr.Item.Type, r.targetType))
+	}
+	return diags
+}
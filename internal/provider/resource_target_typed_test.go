@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	fooTargetTcp = `
+resource "boundary_target_tcp" "foo" {
+	name         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	address      = "127.0.0.1"
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooTargetSsh = `
+resource "boundary_target_ssh" "foo" {
+	name         = "ssh"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	host_source_ids = [
+		boundary_host_set.foo.id
+	]
+	depends_on = [boundary_role.proj1_admin]
+}`
+
+	fooTargetSshRecordingWithoutBucket = `
+resource "boundary_target_ssh" "foo" {
+	name                     = "ssh"
+	scope_id                 = boundary_scope.proj1.id
+	default_port             = 22
+	enable_session_recording = true
+	depends_on               = [boundary_role.proj1_admin]
+}`
+
+	fooTargetTcpSessionRecording = `
+resource "boundary_target_tcp" "foo" {
+	name                     = "tcp"
+	scope_id                 = boundary_scope.proj1.id
+	default_port             = 22
+	enable_session_recording = true
+	depends_on               = [boundary_role.proj1_admin]
+}`
+)
+
+func TestAccTargetTyped(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetTcp, fooTargetSsh),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target_tcp.foo"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", TypeKey, targetTypeTcp),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", targetDefaultPortKey, "22"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", targetAddressKey, "127.0.0.1"),
+					testAccCheckTargetResourceExists(provider, "boundary_target_ssh.foo"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", TypeKey, targetTypeSsh),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target_ssh.foo", []string{"boundary_host_set.foo"}),
+				),
+			},
+			importStep("boundary_target_tcp.foo"),
+			importStep("boundary_target_ssh.foo"),
+		},
+	})
+}
+
+func TestAccTargetTypedPlanValidation(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetSshRecordingWithoutBucket),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be set when"),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetTcpSessionRecording),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unsupported argument"),
+			},
+		},
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTargetAttributes(t *testing.T) {
+	t.Parallel()
+
+	creds := testStringSetValue("clvlt_1234567890")
+
+	tests := []struct {
+		name      string
+		resource  func() resource.Resource
+		values    map[string]tftypes.Value
+		wantError string
+	}{
+		{
+			name:     "tcp target",
+			resource: newTargetResource,
+			values: map[string]tftypes.Value{
+				TypeKey:          tftypes.NewValue(tftypes.String, targetTypeTcp),
+				targetAddressKey: tftypes.NewValue(tftypes.String, "127.0.0.1"),
+			},
+		},
+		{
+			name:     "tcp target with injected credentials",
+			resource: newTargetResource,
+			values: map[string]tftypes.Value{
+				TypeKey:                                 tftypes.NewValue(tftypes.String, targetTypeTcp),
+				targetInjectedAppCredentialSourceIdsKey: creds,
+			},
+			wantError: `"injected_application_credential_source_ids" is not supported on tcp targets`,
+		},
+		{
+			name:     "tcp target with session recording",
+			resource: newTargetResource,
+			values: map[string]tftypes.Value{
+				TypeKey:                         tftypes.NewValue(tftypes.String, targetTypeTcp),
+				targetEnableSessionRecordingKey: tftypes.NewValue(tftypes.Bool, true),
+				targetStorageBucketIdKey:        tftypes.NewValue(tftypes.String, "sb_1234567890"),
+			},
+			wantError: `"enable_session_recording" and "storage_bucket_id" are only supported on ssh and rdp targets`,
+		},
+		{
+			name:     "ssh target with injected credentials",
+			resource: newTargetSshResource,
+			values: map[string]tftypes.Value{
+				targetInjectedAppCredentialSourceIdsKey: creds,
+			},
+		},
+		{
+			name:     "ssh target with session recording",
+			resource: newTargetSshResource,
+			values: map[string]tftypes.Value{
+				targetEnableSessionRecordingKey: tftypes.NewValue(tftypes.Bool, true),
+				targetStorageBucketIdKey:        tftypes.NewValue(tftypes.String, "sb_1234567890"),
+			},
+		},
+		{
+			name:     "rdp target with session recording and no storage bucket",
+			resource: newTargetRdpResource,
+			values: map[string]tftypes.Value{
+				targetEnableSessionRecordingKey: tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantError: `"storage_bucket_id" must be set when "enable_session_recording" is true`,
+		},
+		{
+			name:     "ssh target with session recording and unknown storage bucket",
+			resource: newTargetSshResource,
+			values: map[string]tftypes.Value{
+				targetEnableSessionRecordingKey: tftypes.NewValue(tftypes.Bool, true),
+				targetStorageBucketIdKey:        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name:     "address with host sources",
+			resource: newTargetSshResource,
+			values: map[string]tftypes.Value{
+				targetAddressKey:       tftypes.NewValue(tftypes.String, "127.0.0.1"),
+				targetHostSourceIdsKey: testStringSetValue("hsst_1234567890"),
+			},
+			wantError: `"address": conflicts with host_source_ids`,
+		},
+		{
+			name:     "invalid type",
+			resource: newTargetResource,
+			values: map[string]tftypes.Value{
+				TypeKey: tftypes.NewValue(tftypes.String, "http"),
+			},
+			wantError: `expected type to be one of ["tcp" "ssh" "rdp"], got http`,
+		},
+		{
+			name:     "unknown type",
+			resource: newTargetResource,
+			values: map[string]tftypes.Value{
+				TypeKey:                                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				targetInjectedAppCredentialSourceIdsKey: creds,
+			},
+		},
+		{
+			name:     "invalid port",
+			resource: newTargetTcpResource,
+			values: map[string]tftypes.Value{
+				targetDefaultClientPortKey: tftypes.NewValue(tftypes.Number, 70000),
+			},
+			wantError: `expected "default_client_port" to be a valid port number, got: 70000`,
+		},
+		{
+			name:     "unlimited connections",
+			resource: newTargetTcpResource,
+			values: map[string]tftypes.Value{
+				targetSessionConnectionLimitKey: tftypes.NewValue(tftypes.Number, -1),
+				targetSessionMaxSecondsKey:      tftypes.NewValue(tftypes.Number, 3600),
+			},
+		},
+		{
+			name:     "invalid connection limit",
+			resource: newTargetTcpResource,
+			values: map[string]tftypes.Value{
+				targetSessionConnectionLimitKey: tftypes.NewValue(tftypes.Number, 0),
+			},
+			wantError: `expected "session_connection_limit" to be -1 or at least (1), got 0`,
+		},
+		{
+			name:     "invalid session handling",
+			resource: newTargetTcpResource,
+			values: map[string]tftypes.Value{
+				targetSessionHandlingKey: testTargetSessionHandlingValue(targetSessionHandlingWaitUpTo, ""),
+			},
+			wantError: `"timeout" must be set when the "wait_up_to" mode is used`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tt.resource().(*targetResource)
+			state := testResourceState(t, r, tt.values)
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, &resp)
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Detail())
+		})
+	}
+}
+
+func TestResourceTargetTypedSchema(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var tcp resource.SchemaResponse
+	newTargetTcpResource().Schema(ctx, resource.SchemaRequest{}, &tcp)
+	require.False(t, tcp.Diagnostics.HasError())
+	for _, k := range []string{targetInjectedAppCredentialSourceIdsKey, targetEnableSessionRecordingKey, targetStorageBucketIdKey} {
+		assert.NotContains(t, tcp.Schema.Attributes, k, "boundary_target_tcp should not have %q", k)
+	}
+
+	for _, r := range []resource.Resource{newTargetSshResource(), newTargetRdpResource()} {
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		require.False(t, resp.Diagnostics.HasError())
+		for _, k := range []string{targetInjectedAppCredentialSourceIdsKey, targetEnableSessionRecordingKey, targetStorageBucketIdKey} {
+			assert.Contains(t, resp.Schema.Attributes, k)
+		}
+		typeAttribute := resp.Schema.Attributes[TypeKey]
+		assert.True(t, typeAttribute.IsComputed(), "expected type to be computed")
+		assert.False(t, typeAttribute.IsRequired(), "expected type to be computed")
+	}
+}
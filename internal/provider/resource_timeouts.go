@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultResourceTimeout bounds the operations of the resources that have no
+// specific timeout in resourceTimeouts
+const defaultResourceTimeout = 5 * time.Minute
+
+// resourceTimeout holds the default timeouts of a resource, a zero value uses
+// defaultResourceTimeout
+type resourceTimeout struct {
+	create, read, update, delete time.Duration
+}
+
+// resourceTimeouts are the default timeouts of the resources whose operations
+// can take longer than defaultResourceTimeout on busy controllers
+var resourceTimeouts = map[string]resourceTimeout{
+	// The aliases and hosts are managed with many requests
+	"boundary_alias_target_set": {create: 10 * time.Minute, update: 10 * time.Minute, delete: 10 * time.Minute},
+	"boundary_host_static_set":  {create: 20 * time.Minute, update: 20 * time.Minute, delete: 20 * time.Minute},
+	// The controller validates the credentials with the cloud provider
+	"boundary_host_catalog_plugin": {create: 10 * time.Minute, update: 10 * time.Minute},
+	// wait_for_sync waits up to 10 minutes by default
+	"boundary_host_set_plugin": {create: 20 * time.Minute, update: 20 * time.Minute},
+	// Deleting a scope deletes everything it contains
+	"boundary_scope": {delete: 30 * time.Minute},
+	// The policy is reapplied with a request per session recording
+	"boundary_session_recording_reapply_storage_policy": {create: 20 * time.Minute},
+	// The controller tests the bucket with the storage plugin
+	"boundary_storage_bucket": {create: 10 * time.Minute, update: 10 * time.Minute},
+	// session_handling may wait for the active sessions to end
+	"boundary_target":     {update: 20 * time.Minute, delete: 20 * time.Minute},
+	"boundary_target_rdp": {update: 20 * time.Minute, delete: 20 * time.Minute},
+	"boundary_target_ssh": {update: 20 * time.Minute, delete: 20 * time.Minute},
+	"boundary_target_tcp": {update: 20 * time.Minute, delete: 20 * time.Minute},
+}
+
+// addResourceTimeouts gives every resource of the provider a timeouts block.
+// The SDK uses the timeouts as the deadline of the context passed to the
+// operations, which bounds every request made to the controller.
+func addResourceTimeouts(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if r.Timeouts != nil {
+			continue
+		}
+		r.Timeouts = newResourceTimeouts(r, resourceTimeouts[name])
+	}
+}
+
+// newResourceTimeouts returns the timeouts of the operations r implements
+func newResourceTimeouts(r *schema.Resource, t resourceTimeout) *schema.ResourceTimeout {
+	t = t.withDefaults()
+	timeouts := &schema.ResourceTimeout{
+		Create: &t.create,
+		Read:   &t.read,
+		Delete: &t.delete,
+	}
+	if r.UpdateContext != nil {
+		timeouts.Update = &t.update
+	}
+	return timeouts
+}
+
+// resourceTimeoutsFor returns the default timeouts of the named resource
+func resourceTimeoutsFor(name string) resourceTimeout {
+	return resourceTimeouts[name].withDefaults()
+}
+
+// withDefaults returns t with its zero timeouts set to defaultResourceTimeout
+func (t resourceTimeout) withDefaults() resourceTimeout {
+	for _, d := range []*time.Duration{&t.create, &t.read, &t.update, &t.delete} {
+		if *d == 0 {
+			*d = defaultResourceTimeout
+		}
+	}
+	return t
+}
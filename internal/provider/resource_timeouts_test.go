@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTimeouts(t *testing.T) {
+	t.Parallel()
+
+	p := New()
+	for name, r := range p.ResourcesMap {
+		require.NotNil(t, r.Timeouts, name)
+		assert.NotNil(t, r.Timeouts.Create, name)
+		assert.NotNil(t, r.Timeouts.Read, name)
+		assert.NotNil(t, r.Timeouts.Delete, name)
+		assert.Equal(t, r.UpdateContext != nil, r.Timeouts.Update != nil, name)
+	}
+
+	catalog := p.ResourcesMap["boundary_host_catalog_plugin"].Timeouts
+	assert.Equal(t, 10*time.Minute, *catalog.Create)
+	assert.Equal(t, defaultResourceTimeout, *catalog.Delete)
+
+	// The framework resources use the same defaults
+	scope := resourceTimeoutsFor("boundary_scope")
+	assert.Equal(t, 30*time.Minute, scope.delete)
+	assert.Equal(t, defaultResourceTimeout, scope.create)
+
+	// Resources without an update have no update timeout to configure
+	attachment := p.ResourcesMap["boundary_target_host_source"].Timeouts
+	assert.Nil(t, attachment.Update)
+}
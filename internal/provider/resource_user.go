@@ -5,235 +5,334 @@ package provider
 
 import (
 	"context"
-	"net/http"
+	"fmt"
 
-	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/users"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const userAccountIDsKey = "account_ids"
 
-func resourceUser() *schema.Resource {
-	return &schema.Resource{
-		Description: "The user resource allows you to configure a Boundary user.",
+// userResource is written with the plugin framework, its schema and state are
+// the ones of the SDK version it replaced
+type userResource struct {
+	md *metaData
+}
 
-		CreateContext: resourceUserCreate,
-		ReadContext:   resourceUserRead,
-		UpdateContext: resourceUserUpdate,
-		DeleteContext: resourceUserDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var (
+	_ resource.Resource                 = &userResource{}
+	_ resource.ResourceWithConfigure    = &userResource{}
+	_ resource.ResourceWithImportState  = &userResource{}
+	_ resource.ResourceWithIdentity     = &userResource{}
+	_ resource.ResourceWithUpgradeState = &userResource{}
+)
+
+func newUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	ScopeID     types.String   `tfsdk:"scope_id"`
+	AccountIDs  types.Set      `tfsdk:"account_ids"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
 
-		Schema: map[string]*schema.Schema{
-			IDKey: {
-				Description: "The ID of the user.",
-				Type:        schema.TypeString,
-				Computed:    true,
+type userResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The user resource allows you to configure a Boundary user.",
+		// the states of the SDK version are at version 0
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			IDKey: schema.StringAttribute{
+				MarkdownDescription: "The ID of the user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			NameKey: {
-				Description: "The username. Defaults to the resource name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			NameKey: schema.StringAttribute{
+				MarkdownDescription: "The username. Defaults to the resource name.",
+				Optional:            true,
 			},
-			DescriptionKey: {
-				Description: "The user description.",
-				Type:        schema.TypeString,
-				Optional:    true,
+			DescriptionKey: schema.StringAttribute{
+				MarkdownDescription: "The user description.",
+				Optional:            true,
 			},
-			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+			ScopeIdKey: schema.StringAttribute{
+				MarkdownDescription: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			userAccountIDsKey: {
-				Description: "Account ID's to associate with this user resource.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			userAccountIDsKey: schema.SetAttribute{
+				MarkdownDescription: "Account ID's to associate with this user resource.",
+				ElementType:         types.StringType,
+				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func setFromUserResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
-	if err := d.Set(NameKey, raw["name"]); err != nil {
-		return err
-	}
-	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
-		return err
+func (r *userResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return sdkStateUpgraders(ctx, r)
+}
+
+func (r *userResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			IDKey: identityschema.StringAttribute{
+				Description:       "The ID of the user.",
+				RequiredForImport: true,
+			},
+		},
 	}
-	if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
-		return err
+}
+
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
-	if err := d.Set(userAccountIDsKey, raw["account_ids"]); err != nil {
-		return err
+	md, ok := req.ProviderData.(*metaData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *metaData, got %T", req.ProviderData))
+		return
 	}
-	d.SetId(raw["id"].(string))
-	return nil
+	r.md = md
 }
 
-func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (errs diag.Diagnostics) {
-	md := meta.(*metaData)
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root(IDKey), path.Root(IDKey), req, resp)
+}
 
-	var scopeId string
-	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
-		scopeId = scopeIdVal.(string)
-	} else {
-		return diag.Errorf("no scope ID provided")
-	}
+// setFromUserResponseMap sets the model from the user returned by the
+// controller. Empty values are not returned by the controller, the ones set
+// to an empty value in the configuration are kept so the state matches it.
+func setFromUserResponseMap(ctx context.Context, m *userResourceModel, raw map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(raw["id"].(string))
+	m.Name = stringFromResponseMap(raw, "name", m.Name)
+	m.Description = stringFromResponseMap(raw, "description", m.Description)
+	m.ScopeID = stringFromResponseMap(raw, "scope_id", m.ScopeID)
+	m.AccountIDs, diags = stringSetFromResponseMap(ctx, raw, "account_ids", m.AccountIDs)
+	return diags
+}
 
-	opts := []users.Option{}
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	nameVal, ok := d.GetOk(NameKey)
-	if ok {
-		nameStr := nameVal.(string)
-		opts = append(opts, users.WithName(nameStr))
+	timeout, diags := plan.Timeouts.Create(ctx, resourceTimeoutsFor("boundary_user").create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	descVal, ok := d.GetOk(DescriptionKey)
-	if ok {
-		descStr := descVal.(string)
-		opts = append(opts, users.WithDescription(descStr))
+	opts := []users.Option{}
+	if !plan.Name.IsNull() {
+		opts = append(opts, users.WithName(plan.Name.ValueString()))
+	}
+	if !plan.Description.IsNull() {
+		opts = append(opts, users.WithDescription(plan.Description.ValueString()))
 	}
 
-	usrs := users.NewClient(md.client)
+	usrs := users.NewClient(r.md.client)
 
-	ucr, err := usrs.Create(ctx, scopeId, opts...)
+	ucr, err := usrs.Create(ctx, plan.ScopeID.ValueString(), opts...)
 	if err != nil {
-		return diag.Errorf("error creating user: %v", err)
+		resp.Diagnostics.AddError("Error creating user", err.Error())
+		return
 	}
 	if ucr == nil {
-		return diag.Errorf("user nil after create")
+		resp.Diagnostics.AddError("Error creating user", "user nil after create")
+		return
 	}
 	apiResponse := ucr.GetResponse().Map
-	defer func() {
-		if err := setFromUserResponseMap(d, apiResponse); err != nil {
-			errs = append(errs, diag.FromErr(err)...)
-		}
-	}()
 
-	if val, ok := d.GetOk(userAccountIDsKey); ok {
-		list := val.(*schema.Set).List()
-		acctIds := make([]string, 0, len(list))
-		for _, i := range list {
-			acctIds = append(acctIds, i.(string))
-		}
-		usrac, err := usrs.SetAccounts(ctx, ucr.Item.Id, ucr.Item.Version, acctIds)
-		if err != nil {
-			return diag.Errorf("error setting accounts on user: %v", err)
-		}
-		if usrac == nil {
-			return diag.Errorf("user nil after setting accounts")
+	accountIds, diags := stringsFromSet(ctx, plan.AccountIDs)
+	resp.Diagnostics.Append(diags...)
+	if len(accountIds) > 0 && !resp.Diagnostics.HasError() {
+		usrac, err := usrs.SetAccounts(ctx, ucr.Item.Id, ucr.Item.Version, accountIds)
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddError("Error setting accounts on user", err.Error())
+		case usrac == nil:
+			resp.Diagnostics.AddError("Error setting accounts on user", "user nil after setting accounts")
+		default:
+			apiResponse = usrac.GetResponse().Map
 		}
-		apiResponse = usrac.GetResponse().Map
 	}
 
-	return nil
+	// The user was created, it is saved even if setting its accounts failed
+	// so it is not left behind
+	resp.Diagnostics.Append(setFromUserResponseMap(ctx, &plan, apiResponse)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userResourceIdentityModel{ID: plan.ID})...)
 }
 
-func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	usrs := users.NewClient(md.client)
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, resourceTimeoutsFor("boundary_user").read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	urr, err := usrs.Read(ctx, d.Id())
+	urr, err := users.NewClient(r.md.client).Read(ctx, state.ID.ValueString())
 	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
-			d.SetId("")
-			return nil
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		return diag.Errorf("error calling read user: %v", err)
+		resp.Diagnostics.AddError("Error calling read user", err.Error())
+		return
 	}
 	if urr == nil {
-		return diag.Errorf("user nil after read")
+		resp.Diagnostics.AddError("Error calling read user", "user nil after read")
+		return
 	}
 
-	if err := setFromUserResponseMap(d, urr.GetResponse().Map); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(setFromUserResponseMap(ctx, &state, urr.GetResponse().Map)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userResourceIdentityModel{ID: state.ID})...)
 }
 
-func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	usrs := users.NewClient(md.client)
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	opts := []users.Option{}
+	timeout, diags := plan.Timeouts.Update(ctx, resourceTimeoutsFor("boundary_user").update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	usrs := users.NewClient(r.md.client)
+	id := state.ID.ValueString()
 
-	var name *string
-	if d.HasChange(NameKey) {
+	opts := []users.Option{}
+	if !plan.Name.Equal(state.Name) {
 		opts = append(opts, users.DefaultName())
-		nameVal, ok := d.GetOk(NameKey)
-		if ok {
-			nameStr := nameVal.(string)
-			name = &nameStr
-			opts = append(opts, users.WithName(nameStr))
+		if !plan.Name.IsNull() {
+			opts = append(opts, users.WithName(plan.Name.ValueString()))
 		}
 	}
-
-	var desc *string
-	if d.HasChange(DescriptionKey) {
+	if !plan.Description.Equal(state.Description) {
 		opts = append(opts, users.DefaultDescription())
-		descVal, ok := d.GetOk(DescriptionKey)
-		if ok {
-			descStr := descVal.(string)
-			desc = &descStr
-			opts = append(opts, users.WithDescription(descStr))
+		if !plan.Description.IsNull() {
+			opts = append(opts, users.WithDescription(plan.Description.ValueString()))
 		}
 	}
 
+	apiResponse := map[string]interface{}{}
 	if len(opts) > 0 {
 		opts = append(opts, users.WithAutomaticVersioning(true))
-		_, err := usrs.Update(ctx, d.Id(), 0, opts...)
+		uur, err := retryOnVersionConflict(ctx, func() (*users.UserUpdateResult, error) {
+			return usrs.Update(ctx, id, 0, opts...)
+		})
 		if err != nil {
-			return diag.Errorf("error updating user: %v", err)
-		}
-	}
-
-	if d.HasChange(NameKey) {
-		if err := d.Set(NameKey, name); err != nil {
-			return diag.FromErr(err)
+			resp.Diagnostics.AddError("Error updating user", err.Error())
+			return
 		}
+		apiResponse = uur.GetResponse().Map
 	}
-	if d.HasChange(DescriptionKey) {
-		if err := d.Set(DescriptionKey, desc); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	if d.HasChange(userAccountIDsKey) {
-		var accountIds []string
-		if accountsVal, ok := d.GetOk(userAccountIDsKey); ok {
-			accounts := accountsVal.(*schema.Set).List()
-			for _, account := range accounts {
-				accountIds = append(accountIds, account.(string))
-			}
 
+	if !plan.AccountIDs.Equal(state.AccountIDs) {
+		accountIds, diags := stringsFromSet(ctx, plan.AccountIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		_, err := usrs.SetAccounts(ctx, d.Id(), 0, accountIds, users.WithAutomaticVersioning(true))
+		usac, err := retryOnVersionConflict(ctx, func() (*users.UserUpdateResult, error) {
+			return usrs.SetAccounts(ctx, id, 0, accountIds, users.WithAutomaticVersioning(true))
+		})
 		if err != nil {
-			return diag.Errorf("error updating accounts on user: %v", err)
-		}
-		if err := d.Set(userAccountIDsKey, accountIds); err != nil {
-			return diag.FromErr(err)
+			resp.Diagnostics.AddError("Error updating accounts on user", err.Error())
+			return
 		}
+		apiResponse = usac.GetResponse().Map
 	}
 
-	return nil
+	if len(apiResponse) == 0 {
+		// Only the timeouts changed
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, userResourceIdentityModel{ID: plan.ID})...)
+		return
+	}
+	resp.Diagnostics.Append(setFromUserResponseMap(ctx, &plan, apiResponse)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userResourceIdentityModel{ID: plan.ID})...)
 }
 
-func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	usrs := users.NewClient(md.client)
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	_, err := usrs.Delete(ctx, d.Id())
-	if err != nil {
-		return diag.Errorf("error deleting user: %v", err)
+	timeout, diags := state.Timeouts.Delete(ctx, resourceTimeoutsFor("boundary_user").delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	return nil
+	_, err := users.NewClient(r.md.client).Delete(ctx, state.ID.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting user", err.Error())
+	}
 }
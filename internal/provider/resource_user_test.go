@@ -70,8 +70,8 @@ func TestAccUser(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckUserResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckUserResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -105,8 +105,8 @@ func TestAccUserWithAccounts(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckUserResourceDestroy(t, provider),
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckUserResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// test create
@@ -137,6 +137,49 @@ func TestAccUserWithAccounts(t *testing.T) {
 	})
 }
 
+// TestAccUserStateCompatibility checks the state written by the last release
+// serving boundary_user with the SDK is used as is by the framework version
+func TestAccUserStateCompatibility(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+	token := tc.Token().Token
+
+	var provider *schema.Provider
+	factories := providerFactories(&provider)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCheckUserResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the last release serving boundary_user with the SDK
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"boundary": {
+						Source:            "hashicorp/boundary",
+						VersionConstraint: "1.5.2",
+					},
+				},
+				Config: testConfigWithToken(url, token, fooOrg, fooAccount, orgUserWithAccts),
+			},
+			{
+				// the framework version plans no change from the state of the release
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, fooAccount, orgUserWithAccts),
+				PlanOnly:                 true,
+			},
+			{
+				// the state is refreshed and saved by the framework version
+				ProtoV5ProviderFactories: factories,
+				Config:                   testConfigWithToken(url, token, fooOrg, fooAccount, orgUserWithAccts),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserResourceExists(provider, "boundary_user.org1"),
+					resource.TestCheckResourceAttr("boundary_user.org1", DescriptionKey, "with accts"),
+					resource.TestCheckResourceAttr("boundary_user.org1", "account_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckUserResourceAccountsSet(testProvider *schema.Provider, name string, accounts []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	storageBucketAwsKey   = "aws"
+	storageBucketMinioKey = "minio"
+
+	storageBucketPluginNameAws   = "aws"
+	storageBucketPluginNameMinio = "minio"
+
+	storageBucketRegionKey                    = "region"
+	storageBucketEndpointUrlKey               = "endpoint_url"
+	storageBucketRoleArnKey                   = "role_arn"
+	storageBucketRoleExternalIdKey            = "role_external_id"
+	storageBucketRoleSessionNameKey           = "role_session_name"
+	storageBucketRoleTagsKey                  = "role_tags"
+	storageBucketDisableCredentialRotationKey = "disable_credential_rotation"
+	storageBucketAccessKeyIdKey               = "access_key_id"
+	storageBucketSecretAccessKeyKey           = "secret_access_key"
+)
+
+// storageBucketPluginBlocks maps each typed plugin block to the plugin name
+// that backs it.
+var storageBucketPluginBlocks = map[string]string{
+	storageBucketAwsKey:   storageBucketPluginNameAws,
+	storageBucketMinioKey: storageBucketPluginNameMinio,
+}
+
+// storageBucketPluginBlockAttributes lists the block fields that are sent to
+// Boundary as plugin attributes, per block. Anything not listed here is a
+// secret.
+var storageBucketPluginBlockAttributes = map[string][]string{
+	storageBucketAwsKey: {
+		storageBucketRegionKey,
+		storageBucketEndpointUrlKey,
+		storageBucketRoleArnKey,
+		storageBucketRoleExternalIdKey,
+		storageBucketRoleSessionNameKey,
+		storageBucketRoleTagsKey,
+		storageBucketDisableCredentialRotationKey,
+	},
+	storageBucketMinioKey: {
+		storageBucketRegionKey,
+		storageBucketEndpointUrlKey,
+		storageBucketDisableCredentialRotationKey,
+	},
+}
+
+func storageBucketStaticCredentialsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		storageBucketAccessKeyIdKey: {
+			Description: "The access key ID used for static credentials. Must be set together with " + storageBucketSecretAccessKeyKey + ".",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+		storageBucketSecretAccessKeyKey: {
+			Description: "The secret access key used for static credentials. Must be set together with " + storageBucketAccessKeyIdKey + ".",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+	}
+}
+
+func storageBucketAwsSchema() *schema.Schema {
+	s := storageBucketStaticCredentialsSchema()
+	s[storageBucketRegionKey] = &schema.Schema{
+		Description: "The AWS region the bucket is located in.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s[storageBucketEndpointUrlKey] = &schema.Schema{
+		Description: "A custom S3 endpoint URL, e.g. for a VPC endpoint.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s[storageBucketRoleArnKey] = &schema.Schema{
+		Description: "The ARN of the role to assume for dynamic credentials. Cannot be used with static credentials.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s[storageBucketRoleExternalIdKey] = &schema.Schema{
+		Description: "The external ID used when assuming " + storageBucketRoleArnKey + ".",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s[storageBucketRoleSessionNameKey] = &schema.Schema{
+		Description: "The session name used when assuming " + storageBucketRoleArnKey + ".",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s[storageBucketRoleTagsKey] = &schema.Schema{
+		Description: "The session tags used when assuming " + storageBucketRoleArnKey + ".",
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+	}
+	s[storageBucketDisableCredentialRotationKey] = &schema.Schema{
+		Description: "Disables rotation of static credentials by Boundary. Must be true when using dynamic credentials.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+
+	return &schema.Schema{
+		Description: "Typed configuration for the AWS S3 storage plugin. Either static credentials (" + storageBucketAccessKeyIdKey +
+			" and " + storageBucketSecretAccessKeyKey + ") or dynamic credentials (" + storageBucketRoleArnKey + ") must be configured. " +
+			"Cannot be used with " + AttributesJsonKey + " or " + SecretsJsonKey + ".",
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{AttributesJsonKey, SecretsJsonKey, storageBucketMinioKey},
+		Elem:          &schema.Resource{Schema: s},
+	}
+}
+
+func storageBucketMinioSchema() *schema.Schema {
+	s := storageBucketStaticCredentialsSchema()
+	s[storageBucketEndpointUrlKey] = &schema.Schema{
+		Description: "The URL of the MinIO server.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s[storageBucketRegionKey] = &schema.Schema{
+		Description: "The region configured on the MinIO server, if any.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s[storageBucketDisableCredentialRotationKey] = &schema.Schema{
+		Description: "Disables rotation of the service account credentials by Boundary.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+
+	return &schema.Schema{
+		Description: "Typed configuration for the MinIO storage plugin. The MinIO plugin only supports static credentials (" +
+			storageBucketAccessKeyIdKey + " and " + storageBucketSecretAccessKeyKey + "). " +
+			"Cannot be used with " + AttributesJsonKey + " or " + SecretsJsonKey + ".",
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{AttributesJsonKey, SecretsJsonKey, storageBucketAwsKey},
+		Elem:          &schema.Resource{Schema: s},
+	}
+}
+
+// storageBucketPluginBlock returns the name and contents of the typed plugin
+// block in use, if any.
+func storageBucketPluginBlock(d resourceDataGetter) (string, map[string]interface{}, bool) {
+	for _, key := range []string{storageBucketAwsKey, storageBucketMinioKey} {
+		raw, ok := d.GetOk(key)
+		if !ok {
+			continue
+		}
+		blocks := raw.([]interface{})
+		if len(blocks) == 0 || blocks[0] == nil {
+			continue
+		}
+		return key, blocks[0].(map[string]interface{}), true
+	}
+	return "", nil, false
+}
+
+// storageBucketPluginBlockKnown reports whether every field of the typed
+// block is known at plan time. Blocks with unknown values are left for the
+// server to validate on apply.
+func storageBucketPluginBlockKnown(d *schema.ResourceDiff, key string) bool {
+	fields := append([]string{storageBucketAccessKeyIdKey, storageBucketSecretAccessKeyKey}, storageBucketPluginBlockAttributes[key]...)
+	for _, field := range fields {
+		if !d.NewValueKnown(fmt.Sprintf("%s.0.%s", key, field)) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateStorageBucketPluginBlock checks that the typed block describes a
+// credential setup the plugin accepts. Static credentials are only required
+// on create; afterwards they may be removed from config, in which case the
+// values already stored in Boundary are left alone.
+func validateStorageBucketPluginBlock(key string, block map[string]interface{}, isNew bool) error {
+	accessKeyId, _ := block[storageBucketAccessKeyIdKey].(string)
+	secretAccessKey, _ := block[storageBucketSecretAccessKeyKey].(string)
+	roleArn, _ := block[storageBucketRoleArnKey].(string)
+	disableRotation, _ := block[storageBucketDisableCredentialRotationKey].(bool)
+
+	if (accessKeyId == "") != (secretAccessKey == "") {
+		return fmt.Errorf("%s: %s and %s must be set together", key, storageBucketAccessKeyIdKey, storageBucketSecretAccessKeyKey)
+	}
+	hasStatic := accessKeyId != ""
+
+	switch key {
+	case storageBucketAwsKey:
+		if region, _ := block[storageBucketRegionKey].(string); region == "" {
+			return fmt.Errorf("%s: %s is required", key, storageBucketRegionKey)
+		}
+		switch {
+		case roleArn != "":
+			// Dynamic credentials
+			if hasStatic {
+				return fmt.Errorf("%s: %s cannot be used together with static credentials", key, storageBucketRoleArnKey)
+			}
+			if !disableRotation {
+				return fmt.Errorf("%s: %s must be true when using dynamic credentials", key, storageBucketDisableCredentialRotationKey)
+			}
+		default:
+			// Static credentials
+			for _, k := range []string{storageBucketRoleExternalIdKey, storageBucketRoleSessionNameKey} {
+				if v, _ := block[k].(string); v != "" {
+					return fmt.Errorf("%s: %s requires %s", key, k, storageBucketRoleArnKey)
+				}
+			}
+			if tags, _ := block[storageBucketRoleTagsKey].(map[string]interface{}); len(tags) > 0 {
+				return fmt.Errorf("%s: %s requires %s", key, storageBucketRoleTagsKey, storageBucketRoleArnKey)
+			}
+			if isNew && !hasStatic {
+				return fmt.Errorf("%s: either %s and %s or %s must be set", key, storageBucketAccessKeyIdKey, storageBucketSecretAccessKeyKey, storageBucketRoleArnKey)
+			}
+		}
+
+	case storageBucketMinioKey:
+		if endpointUrl, _ := block[storageBucketEndpointUrlKey].(string); endpointUrl == "" {
+			return fmt.Errorf("%s: %s is required", key, storageBucketEndpointUrlKey)
+		}
+		if isNew && !hasStatic {
+			return fmt.Errorf("%s: %s and %s are required", key, storageBucketAccessKeyIdKey, storageBucketSecretAccessKeyKey)
+		}
+
+	default:
+		return fmt.Errorf("unknown storage bucket plugin block %q", key)
+	}
+
+	return nil
+}
+
+// validateStorageBucketPluginName ensures a typed block is not used with a
+// plugin_name that belongs to a different plugin.
+func validateStorageBucketPluginName(key, pluginName string) error {
+	if pluginName == "" {
+		return nil
+	}
+	if want := storageBucketPluginBlocks[key]; pluginName != want {
+		return fmt.Errorf("the %q block can only be used with plugin_name %q, got %q", key, want, pluginName)
+	}
+	return nil
+}
+
+// expandStorageBucketPluginBlock converts a typed block into the attributes
+// and secrets maps sent to Boundary. Empty values are omitted so they fall
+// back to the plugin's defaults; secrets is nil if no credentials are set.
+func expandStorageBucketPluginBlock(key string, block map[string]interface{}) (attrs, secrets map[string]interface{}) {
+	attrs = make(map[string]interface{})
+	for _, k := range storageBucketPluginBlockAttributes[key] {
+		switch v := block[k].(type) {
+		case string:
+			if v != "" {
+				attrs[k] = v
+			}
+		case bool:
+			if v {
+				attrs[k] = v
+			}
+		case map[string]interface{}:
+			if len(v) > 0 {
+				attrs[k] = v
+			}
+		}
+	}
+
+	accessKeyId, _ := block[storageBucketAccessKeyIdKey].(string)
+	secretAccessKey, _ := block[storageBucketSecretAccessKeyKey].(string)
+	if accessKeyId != "" || secretAccessKey != "" {
+		secrets = map[string]interface{}{
+			storageBucketAccessKeyIdKey:     accessKeyId,
+			storageBucketSecretAccessKeyKey: secretAccessKey,
+		}
+	}
+
+	return attrs, secrets
+}
+
+// flattenStorageBucketPluginBlock builds the typed block from the attributes
+// returned by Boundary. Secrets are never returned, so the credentials are
+// carried over from the prior block.
+func flattenStorageBucketPluginBlock(key string, attrs, prior map[string]interface{}) []interface{} {
+	m := make(map[string]interface{})
+	for _, k := range storageBucketPluginBlockAttributes[key] {
+		v, ok := attrs[k]
+		if !ok {
+			continue
+		}
+		if k == storageBucketDisableCredentialRotationKey {
+			// Older plugins accepted and echoed back a string here
+			if s, isString := v.(string); isString {
+				v = s == "true"
+			}
+		}
+		m[k] = v
+	}
+	for _, k := range []string{storageBucketAccessKeyIdKey, storageBucketSecretAccessKeyKey} {
+		if v, ok := prior[k]; ok {
+			m[k] = v
+		}
+	}
+	return []interface{}{m}
+}
+
+// storageBucketUpdateAttributes returns the attributes sent to Boundary when
+// the typed plugin block or attributes_json changed, nil when they are reset
+// to their defaults. Attributes are merged by Boundary, so the keys set
+// before, through the typed block or attributes_json, that are no longer set
+// are explicitly cleared.
+func storageBucketUpdateAttributes(d *schema.ResourceData) (map[string]interface{}, error) {
+	var attrs map[string]interface{}
+	if key, block, ok := storageBucketPluginBlock(d); ok {
+		attrs, _ = expandStorageBucketPluginBlock(key, block)
+	} else {
+		var err error
+		attrs, err = storageBucketAttributesJson(d.Get(AttributesJsonKey).(string))
+		if err != nil {
+			return nil, err
+		}
+		if attrs == nil {
+			return nil, nil
+		}
+	}
+
+	oldJson, _ := d.GetChange(AttributesJsonKey)
+	oldAttrs, err := storageBucketAttributesJson(oldJson.(string))
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{storageBucketAwsKey, storageBucketMinioKey} {
+		oldRaw, _ := d.GetChange(key)
+		if blocks := oldRaw.([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			oldBlockAttrs, _ := expandStorageBucketPluginBlock(key, blocks[0].(map[string]interface{}))
+			if oldAttrs == nil {
+				oldAttrs = make(map[string]interface{}, len(oldBlockAttrs))
+			}
+			for k, v := range oldBlockAttrs {
+				oldAttrs[k] = v
+			}
+		}
+	}
+	for k := range oldAttrs {
+		if _, ok := attrs[k]; !ok {
+			attrs[k] = nil
+		}
+	}
+	return attrs, nil
+}
+
+// storageBucketAttributesJson decodes a value of attributes_json, nil is
+// returned when it is not set or set to "null"
+func storageBucketAttributesJson(raw string) (map[string]interface{}, error) {
+	attrsStr, err := parseutil.ParsePath(raw)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		return nil, fmt.Errorf("error parsing path with attributes: %w", err)
+	}
+	switch attrsStr {
+	case "null", "":
+		return nil, nil
+	}
+	// What comes in is json-encoded but we want to set a
+	// map[string]interface{} so we unmarshal it and set that
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(attrsStr), &m); err != nil {
+		return nil, fmt.Errorf("error unmarshaling attributes: %w", err)
+	}
+	if m == nil {
+		m = map[string]interface{}{}
+	}
+	return m, nil
+}
+
+// storageBucketSecretsJson returns the secrets from config as a JSON string,
+// taken from the typed plugin block if one is in use and from secrets_json
+// otherwise. The result feeds the secrets config HMAC handling, so it must be
+// stable for the same input.
+func storageBucketSecretsJson(d *schema.ResourceData) (string, error) {
+	if key, block, ok := storageBucketPluginBlock(d); ok {
+		_, secrets := expandStorageBucketPluginBlock(key, block)
+		if secrets == nil {
+			return "", nil
+		}
+		encoded, err := json.Marshal(secrets)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling secrets from %q block: %w", key, err)
+		}
+		return string(encoded), nil
+	}
+
+	secretsJson, err := parseutil.ParsePath(d.Get(SecretsJsonKey).(string))
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		return "", fmt.Errorf("error parsing path with secrets: %w", err)
+	}
+	return secretsJson, nil
+}
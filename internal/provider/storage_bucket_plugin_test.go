@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidateStorageBucketPluginBlock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		key       string
+		block     map[string]interface{}
+		isNew     bool
+		wantError bool
+	}{
+		{
+			name: "aws static credentials",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey:          "us-east-1",
+				storageBucketAccessKeyIdKey:     "AKIA",
+				storageBucketSecretAccessKeyKey: "secret",
+			},
+			isNew: true,
+		},
+		{
+			name: "aws dynamic credentials",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey:                    "us-east-1",
+				storageBucketRoleArnKey:                   "arn:aws:iam::123456789012:role/S3Access",
+				storageBucketRoleTagsKey:                  map[string]interface{}{"team": "boundary"},
+				storageBucketDisableCredentialRotationKey: true,
+			},
+			isNew: true,
+		},
+		{
+			name: "aws dynamic credentials with rotation enabled",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey:  "us-east-1",
+				storageBucketRoleArnKey: "arn:aws:iam::123456789012:role/S3Access",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+		{
+			name: "aws mixed credentials",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey:                    "us-east-1",
+				storageBucketRoleArnKey:                   "arn:aws:iam::123456789012:role/S3Access",
+				storageBucketDisableCredentialRotationKey: true,
+				storageBucketAccessKeyIdKey:               "AKIA",
+				storageBucketSecretAccessKeyKey:           "secret",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+		{
+			name: "aws partial static credentials",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey:      "us-east-1",
+				storageBucketAccessKeyIdKey: "AKIA",
+			},
+			wantError: true,
+		},
+		{
+			name: "aws role options without role arn",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey:          "us-east-1",
+				storageBucketRoleSessionNameKey: "boundary",
+				storageBucketAccessKeyIdKey:     "AKIA",
+				storageBucketSecretAccessKeyKey: "secret",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+		{
+			name: "aws no credentials on create",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey: "us-east-1",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+		{
+			name: "aws no credentials on update",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketRegionKey: "us-east-1",
+			},
+		},
+		{
+			name: "aws missing region",
+			key:  storageBucketAwsKey,
+			block: map[string]interface{}{
+				storageBucketAccessKeyIdKey:     "AKIA",
+				storageBucketSecretAccessKeyKey: "secret",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+		{
+			name: "minio static credentials",
+			key:  storageBucketMinioKey,
+			block: map[string]interface{}{
+				storageBucketEndpointUrlKey:     "https://minio.example.com:9000",
+				storageBucketAccessKeyIdKey:     "minio",
+				storageBucketSecretAccessKeyKey: "secret",
+			},
+			isNew: true,
+		},
+		{
+			name: "minio missing endpoint",
+			key:  storageBucketMinioKey,
+			block: map[string]interface{}{
+				storageBucketAccessKeyIdKey:     "minio",
+				storageBucketSecretAccessKeyKey: "secret",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+		{
+			name: "minio no credentials on create",
+			key:  storageBucketMinioKey,
+			block: map[string]interface{}{
+				storageBucketEndpointUrlKey: "https://minio.example.com:9000",
+			},
+			isNew:     true,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateStorageBucketPluginBlock(tt.key, tt.block, tt.isNew)
+			if tt.wantError && err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateStorageBucketPluginName(t *testing.T) {
+	t.Parallel()
+
+	if err := validateStorageBucketPluginName(storageBucketAwsKey, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := validateStorageBucketPluginName(storageBucketAwsKey, "aws"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := validateStorageBucketPluginName(storageBucketMinioKey, "aws"); err == nil {
+		t.Fatal("expected error but got nil")
+	}
+}
+
+func TestExpandStorageBucketPluginBlock(t *testing.T) {
+	t.Parallel()
+
+	block := map[string]interface{}{
+		storageBucketRegionKey:                    "us-east-1",
+		storageBucketEndpointUrlKey:               "",
+		storageBucketRoleArnKey:                   "",
+		storageBucketRoleTagsKey:                  map[string]interface{}{},
+		storageBucketDisableCredentialRotationKey: false,
+		storageBucketAccessKeyIdKey:               "AKIA",
+		storageBucketSecretAccessKeyKey:           "secret",
+	}
+
+	attrs, secrets := expandStorageBucketPluginBlock(storageBucketAwsKey, block)
+	wantAttrs := map[string]interface{}{
+		storageBucketRegionKey: "us-east-1",
+	}
+	if !reflect.DeepEqual(attrs, wantAttrs) {
+		t.Fatalf("unexpected attributes: got %v, want %v", attrs, wantAttrs)
+	}
+	wantSecrets := map[string]interface{}{
+		storageBucketAccessKeyIdKey:     "AKIA",
+		storageBucketSecretAccessKeyKey: "secret",
+	}
+	if !reflect.DeepEqual(secrets, wantSecrets) {
+		t.Fatalf("unexpected secrets: got %v, want %v", secrets, wantSecrets)
+	}
+
+	delete(block, storageBucketAccessKeyIdKey)
+	delete(block, storageBucketSecretAccessKeyKey)
+	if _, secrets := expandStorageBucketPluginBlock(storageBucketAwsKey, block); secrets != nil {
+		t.Fatalf("expected nil secrets, got %v", secrets)
+	}
+}
+
+func TestFlattenStorageBucketPluginBlock(t *testing.T) {
+	t.Parallel()
+
+	attrs := map[string]interface{}{
+		storageBucketRegionKey:                    "us-east-1",
+		storageBucketRoleArnKey:                   "arn:aws:iam::123456789012:role/S3Access",
+		storageBucketDisableCredentialRotationKey: "true",
+		"unknown_attribute":                       "ignored",
+	}
+	prior := map[string]interface{}{
+		storageBucketAccessKeyIdKey:     "AKIA",
+		storageBucketSecretAccessKeyKey: "secret",
+	}
+
+	got := flattenStorageBucketPluginBlock(storageBucketAwsKey, attrs, prior)
+	want := []interface{}{map[string]interface{}{
+		storageBucketRegionKey:                    "us-east-1",
+		storageBucketRoleArnKey:                   "arn:aws:iam::123456789012:role/S3Access",
+		storageBucketDisableCredentialRotationKey: true,
+		storageBucketAccessKeyIdKey:               "AKIA",
+		storageBucketSecretAccessKeyKey:           "secret",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected block: got %v, want %v", got, want)
+	}
+}
+
+func TestStorageBucketUpdateAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		state map[string]string
+		set   map[string]interface{}
+		want  map[string]interface{}
+	}{
+		{
+			name: "attributes_json to aws block",
+			state: map[string]string{
+				AttributesJsonKey: `{"region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/S3Access","custom":"value"}`,
+			},
+			set: map[string]interface{}{
+				AttributesJsonKey: "",
+				storageBucketAwsKey: []interface{}{map[string]interface{}{
+					storageBucketRegionKey: "us-east-1",
+				}},
+			},
+			want: map[string]interface{}{
+				storageBucketRegionKey:  "us-east-1",
+				storageBucketRoleArnKey: nil,
+				"custom":                nil,
+			},
+		},
+		{
+			name: "aws block to attributes_json",
+			state: map[string]string{
+				storageBucketAwsKey + ".#":                                    "1",
+				storageBucketAwsKey + ".0." + storageBucketRegionKey:          "us-east-1",
+				storageBucketAwsKey + ".0." + storageBucketRoleArnKey:         "arn:aws:iam::123456789012:role/S3Access",
+				storageBucketAwsKey + ".0." + storageBucketEndpointUrlKey:     "",
+				storageBucketAwsKey + ".0." + storageBucketRoleTagsKey + ".%": "0",
+			},
+			set: map[string]interface{}{
+				storageBucketAwsKey: []interface{}{},
+				AttributesJsonKey:   `{"region":"eu-west-1"}`,
+			},
+			want: map[string]interface{}{
+				storageBucketRegionKey:  "eu-west-1",
+				storageBucketRoleArnKey: nil,
+			},
+		},
+		{
+			name: "attributes_json keys removed",
+			state: map[string]string{
+				AttributesJsonKey: `{"region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/S3Access"}`,
+			},
+			set: map[string]interface{}{
+				AttributesJsonKey: `{"region":"us-east-1"}`,
+			},
+			want: map[string]interface{}{
+				storageBucketRegionKey:  "us-east-1",
+				storageBucketRoleArnKey: nil,
+			},
+		},
+		{
+			name: "attributes_json unset",
+			state: map[string]string{
+				AttributesJsonKey: `{"region":"us-east-1"}`,
+			},
+			set: map[string]interface{}{
+				AttributesJsonKey: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := resourceStorageBucket().Data(&terraform.InstanceState{ID: "sb_1234567890", Attributes: tt.state})
+			for k, v := range tt.set {
+				if err := d.Set(k, v); err != nil {
+					t.Fatalf("error setting %s: %v", k, err)
+				}
+			}
+			got, err := storageBucketUpdateAttributes(d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("unexpected attributes: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
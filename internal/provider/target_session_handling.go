@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	targetSessionHandlingKey        = "session_handling"
+	targetSessionHandlingModeKey    = "mode"
+	targetSessionHandlingTimeoutKey = "timeout"
+
+	targetSessionHandlingCancel       = "cancel"
+	targetSessionHandlingWaitUpTo     = "wait_up_to"
+	targetSessionHandlingFailIfActive = "fail_if_active"
+)
+
+var targetSessionHandlingModes = []string{
+	targetSessionHandlingCancel,
+	targetSessionHandlingWaitUpTo,
+	targetSessionHandlingFailIfActive,
+}
+
+// targetSessionPollInterval is how often the sessions of a target are listed
+// while waiting for them to finish
+var targetSessionPollInterval = 5 * time.Second
+
+// targetSessionCancelMargin is the time kept before the deadline of the
+// update or delete to change the target and cancel the remaining sessions
+// when wait_up_to is longer than the timeouts of the target allow
+var targetSessionCancelMargin = time.Minute
+
+// targetSessionHandlingModel is the session_handling block of a target
+type targetSessionHandlingModel struct {
+	Mode    types.String `tfsdk:"mode"`
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+func targetSessionHandlingBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "How active sessions of the target are handled when the target is destroyed or its " +
+			"`host_source_ids` or `address` are changed. Sessions are canceled once the change is made, so they are " +
+			"kept if it fails, or before the target is destroyed. Sessions are left running if unset.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				targetSessionHandlingModeKey: schema.StringAttribute{
+					MarkdownDescription: "One of `cancel` to cancel active sessions, `wait_up_to` to wait for active sessions " +
+						"to end for at most `timeout` and cancel the remaining ones, or `fail_if_active` to fail the apply " +
+						"while there are active sessions.",
+					Required: true,
+				},
+				targetSessionHandlingTimeoutKey: schema.StringAttribute{
+					MarkdownDescription: "How long to wait for active sessions to end, e.g. `15m`. Required with the `wait_up_to` mode and only allowed with it. " +
+						"The wait stops a minute before the `update` or `delete` timeout of the target, raise them to wait longer.",
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// validateDuration checks the value is a duration understood by
+// time.ParseDuration
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("%q is not a valid duration: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// validateTargetSessionHandling checks the session_handling block, the values
+// that are not known yet are not checked
+func validateTargetSessionHandling(ctx context.Context, l types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if l.IsNull() || l.IsUnknown() {
+		return diags
+	}
+	blocks := []targetSessionHandlingModel{}
+	if diags.Append(l.ElementsAs(ctx, &blocks, false)...); diags.HasError() {
+		return diags
+	}
+	p := path.Root(targetSessionHandlingKey)
+	if len(blocks) > 1 {
+		diags.AddAttributeError(p, "Too many session_handling blocks", fmt.Sprintf("No more than 1 %q block is allowed", targetSessionHandlingKey))
+		return diags
+	}
+	for _, b := range blocks {
+		switch {
+		case b.Mode.IsUnknown():
+		case b.Mode.ValueString() == targetSessionHandlingWaitUpTo && b.Timeout.IsNull():
+			diags.AddAttributeError(p, "Missing session_handling timeout",
+				fmt.Sprintf("%q must be set when the %q mode is used", targetSessionHandlingTimeoutKey, targetSessionHandlingWaitUpTo))
+		case !slices.Contains(targetSessionHandlingModes, b.Mode.ValueString()):
+			diags.AddAttributeError(p.AtListIndex(0).AtName(targetSessionHandlingModeKey), "Invalid session_handling mode",
+				fmt.Sprintf("expected %s to be one of %q, got %s", targetSessionHandlingModeKey, targetSessionHandlingModes, b.Mode.ValueString()))
+		case b.Mode.ValueString() != targetSessionHandlingWaitUpTo && !b.Timeout.IsNull():
+			diags.AddAttributeError(p.AtListIndex(0).AtName(targetSessionHandlingTimeoutKey), "Unexpected session_handling timeout",
+				fmt.Sprintf("%q can only be set when the %q mode is used", targetSessionHandlingTimeoutKey, targetSessionHandlingWaitUpTo))
+		}
+		if b.Timeout.IsNull() || b.Timeout.IsUnknown() {
+			continue
+		}
+		if _, errs := validateDuration(b.Timeout.ValueString(), targetSessionHandlingTimeoutKey); len(errs) > 0 {
+			diags.AddAttributeError(p.AtListIndex(0).AtName(targetSessionHandlingTimeoutKey), "Invalid session_handling timeout", errs[0].Error())
+		}
+	}
+	return diags
+}
+
+// targetSessionHandling returns the configured mode and timeout, the mode is
+// empty when session_handling is not set
+func targetSessionHandling(ctx context.Context, l types.List) (string, string, error) {
+	if l.IsNull() || l.IsUnknown() {
+		return "", "", nil
+	}
+	blocks := []targetSessionHandlingModel{}
+	if diags := l.ElementsAs(ctx, &blocks, false); diags.HasError() {
+		return "", "", fmt.Errorf("error reading %q: %s", targetSessionHandlingKey, diags.Errors()[0].Detail())
+	}
+	if len(blocks) == 0 {
+		return "", "", nil
+	}
+	return blocks[0].Mode.ValueString(), blocks[0].Timeout.ValueString(), nil
+}
+
+// handleTargetSessions applies the session_handling of the target to its
+// active sessions. It returns once no session is left active or an error if
+// that cannot be achieved with the configured mode.
+func handleTargetSessions(ctx context.Context, md *metaData, m *targetResourceModel) error {
+	active, err := drainTargetSessions(ctx, md, m)
+	if err != nil {
+		return err
+	}
+	return cancelTargetSessions(ctx, md, active)
+}
+
+// drainTargetSessions lists the active sessions of the target and fails or
+// waits for them as configured by session_handling. It returns the sessions
+// left to cancel, none are canceled so an update can be made first and the
+// sessions are kept if it fails.
+func drainTargetSessions(ctx context.Context, md *metaData, m *targetResourceModel) ([]*sessions.Session, error) {
+	mode, timeout, err := targetSessionHandling(ctx, m.SessionHandling)
+	if err != nil {
+		return nil, err
+	}
+	if mode == "" {
+		return nil, nil
+	}
+
+	sc := sessions.NewClient(md.client)
+	targetId := m.ID.ValueString()
+	scopeId := m.ScopeID.ValueString()
+
+	active, err := listActiveTargetSessions(ctx, sc, scopeId, targetId)
+	if err != nil {
+		return nil, err
+	}
+	if len(active) == 0 {
+		return nil, nil
+	}
+
+	switch mode {
+	case targetSessionHandlingFailIfActive:
+		return nil, fmt.Errorf("target %q has %d active session(s) and %q is %q", targetId, len(active), targetSessionHandlingKey, mode)
+
+	case targetSessionHandlingWaitUpTo:
+		wait, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %q: %w", targetSessionHandlingTimeoutKey, err)
+		}
+		deadline := time.Now().Add(wait)
+		if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Add(-targetSessionCancelMargin).Before(deadline) {
+			deadline = ctxDeadline.Add(-targetSessionCancelMargin)
+			tflog.Warn(ctx, "Waiting for the sessions of the target for less than the configured timeout, the timeouts of the target do not leave enough time", map[string]interface{}{
+				"target_id": targetId,
+				"timeout":   timeout,
+			})
+		}
+		for len(active) > 0 && time.Now().Before(deadline) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(min(targetSessionPollInterval, time.Until(deadline))):
+			}
+			if active, err = listActiveTargetSessions(ctx, sc, scopeId, targetId); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The remaining sessions are to be canceled, both for the cancel mode and
+	// once wait_up_to ran out of time
+	return active, nil
+}
+
+// cancelTargetSessions cancels the sessions that are not being canceled yet
+func cancelTargetSessions(ctx context.Context, md *metaData, active []*sessions.Session) error {
+	if len(active) == 0 {
+		return nil
+	}
+	sc := sessions.NewClient(md.client)
+	for _, s := range active {
+		if s.Status == sessionStatusCanceling {
+			continue
+		}
+		if _, err := sc.Cancel(ctx, s.Id, 0, sessions.WithAutomaticVersioning(true)); err != nil {
+			return fmt.Errorf("error canceling session %q: %w", s.Id, err)
+		}
+	}
+	return nil
+}
+
+// listActiveTargetSessions returns the sessions of the target that have not
+// terminated yet
+func listActiveTargetSessions(ctx context.Context, sc *sessions.Client, scopeId, targetId string) ([]*sessions.Session, error) {
+	slr, err := sc.List(ctx, scopeId,
+		sessions.WithFilter(fmt.Sprintf(`"/item/target_id" == %q`, targetId)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions of target %q: %w", targetId, err)
+	}
+
+	var active []*sessions.Session
+	for _, s := range slr.GetItems() {
+		if s.Status != sessionStatusTerminated {
+			active = append(active, s)
+		}
+	}
+	return active, nil
+}
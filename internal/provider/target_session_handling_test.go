@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTargetSessionHandlingValue returns a session_handling block, the
+// timeout is null when empty
+func testTargetSessionHandlingValue(mode, timeout string) tftypes.Value {
+	blockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		targetSessionHandlingModeKey:    tftypes.String,
+		targetSessionHandlingTimeoutKey: tftypes.String,
+	}}
+	timeoutValue := tftypes.NewValue(tftypes.String, nil)
+	if timeout != "" {
+		timeoutValue = tftypes.NewValue(tftypes.String, timeout)
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+		tftypes.NewValue(blockType, map[string]tftypes.Value{
+			targetSessionHandlingModeKey:    tftypes.NewValue(tftypes.String, mode),
+			targetSessionHandlingTimeoutKey: timeoutValue,
+		}),
+	})
+}
+
+func TestTargetSessionHandling(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       tftypes.Value
+		wantMode    string
+		wantTimeout string
+		wantError   bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:     "cancel",
+			value:    testTargetSessionHandlingValue(targetSessionHandlingCancel, ""),
+			wantMode: targetSessionHandlingCancel,
+		},
+		{
+			name:        "wait up to",
+			value:       testTargetSessionHandlingValue(targetSessionHandlingWaitUpTo, "15m"),
+			wantMode:    targetSessionHandlingWaitUpTo,
+			wantTimeout: "15m",
+		},
+		{
+			name:      "wait up to without timeout",
+			value:     testTargetSessionHandlingValue(targetSessionHandlingWaitUpTo, ""),
+			wantMode:  targetSessionHandlingWaitUpTo,
+			wantError: true,
+		},
+		{
+			name:        "invalid timeout",
+			value:       testTargetSessionHandlingValue(targetSessionHandlingWaitUpTo, "soon"),
+			wantMode:    targetSessionHandlingWaitUpTo,
+			wantTimeout: "soon",
+			wantError:   true,
+		},
+		{
+			name:        "cancel with timeout",
+			value:       testTargetSessionHandlingValue(targetSessionHandlingCancel, "15m"),
+			wantMode:    targetSessionHandlingCancel,
+			wantTimeout: "15m",
+			wantError:   true,
+		},
+		{
+			name:        "fail if active with timeout",
+			value:       testTargetSessionHandlingValue(targetSessionHandlingFailIfActive, "15m"),
+			wantMode:    targetSessionHandlingFailIfActive,
+			wantTimeout: "15m",
+			wantError:   true,
+		},
+		{
+			name:      "invalid mode",
+			value:     testTargetSessionHandlingValue("wait", ""),
+			wantMode:  "wait",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			values := map[string]tftypes.Value{}
+			if tt.value.Type() != nil {
+				values[targetSessionHandlingKey] = tt.value
+			}
+			m, diags := (&targetResource{}).get(ctx, testResourceState(t, &targetResource{}, values))
+			require.False(t, diags.HasError(), "%v", diags)
+
+			mode, timeout, err := targetSessionHandling(ctx, m.SessionHandling)
+			require.NoError(t, err)
+			if mode != tt.wantMode || timeout != tt.wantTimeout {
+				t.Fatalf("got mode %q and timeout %q, want %q and %q", mode, timeout, tt.wantMode, tt.wantTimeout)
+			}
+
+			diags = validateTargetSessionHandling(ctx, m.SessionHandling)
+			if tt.wantError && !diags.HasError() {
+				t.Fatal("expected error but got nil")
+			}
+			if !tt.wantError && diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	t.Parallel()
+
+	if _, errs := validateDuration("1h30m", "timeout"); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if _, errs := validateDuration("soon", "timeout"); len(errs) == 0 {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+// testSessionsController fakes the sessions API of a controller for a target
+// with one active session. The session is listed as active the number of
+// times given by activeLists, and every request to update a target is denied.
+type testSessionsController struct {
+	mu          sync.Mutex
+	activeLists int
+	lists       int
+	canceled    []string
+}
+
+func (c *testSessionsController) metaData(t *testing.T) *metaData {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(c.serveHTTP))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+	client.SetMaxRetries(0)
+	return &metaData{client: client}
+}
+
+func (c *testSessionsController) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	const session = `{"id":"s_1234567890","target_id":"ttcp_1234567890","scope_id":"p_1234567890","status":"%s","version":1}`
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/sessions":
+		c.lists++
+		items := ""
+		if c.lists <= c.activeLists {
+			items = fmt.Sprintf(session, sessionStatusActive)
+		}
+		_, _ = fmt.Fprintf(w, `{"items":[%s],"response_type":"complete"}`, items)
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/sessions/s_1234567890":
+		_, _ = fmt.Fprintf(w, session, sessionStatusActive)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, ":cancel"):
+		c.canceled = append(c.canceled, strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/sessions/"), ":cancel"))
+		_, _ = fmt.Fprintf(w, session, sessionStatusCanceling)
+	case strings.HasPrefix(r.URL.Path, "/v1/targets/"):
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"kind":"PermissionDenied","message":"Forbidden."}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"kind":"NotFound","message":"Resource not found."}`))
+	}
+}
+
+func (c *testSessionsController) canceledSessions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.canceled
+}
+
+// testTargetSessionHandlingData returns the state of a target handling its
+// sessions with mode and timeout
+func testTargetSessionHandlingData(t *testing.T, mode, timeout string) tfsdk.State {
+	t.Helper()
+	return testResourceState(t, &targetResource{}, map[string]tftypes.Value{
+		IDKey:                    tftypes.NewValue(tftypes.String, "ttcp_1234567890"),
+		TypeKey:                  tftypes.NewValue(tftypes.String, targetTypeTcp),
+		ScopeIdKey:               tftypes.NewValue(tftypes.String, "p_1234567890"),
+		targetAddressKey:         tftypes.NewValue(tftypes.String, "127.0.0.1"),
+		targetSessionHandlingKey: testTargetSessionHandlingValue(mode, timeout),
+	})
+}
+
+// testTargetSessionHandlingModel returns the model of a target handling its
+// sessions with mode and timeout
+func testTargetSessionHandlingModel(t *testing.T, mode, timeout string) *targetResourceModel {
+	t.Helper()
+	m, diags := (&targetResource{}).get(context.Background(), testTargetSessionHandlingData(t, mode, timeout))
+	require.False(t, diags.HasError(), "%v", diags)
+	return &m
+}
+
+func TestHandleTargetSessions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mode         string
+		timeout      string
+		activeLists  int
+		wantError    bool
+		wantCanceled []string
+	}{
+		{
+			name:         "cancel",
+			mode:         targetSessionHandlingCancel,
+			activeLists:  1,
+			wantCanceled: []string{"s_1234567890"},
+		},
+		{
+			name:        "fail if active",
+			mode:        targetSessionHandlingFailIfActive,
+			activeLists: 1,
+			wantError:   true,
+		},
+		{
+			name:        "fail if active without sessions",
+			mode:        targetSessionHandlingFailIfActive,
+			activeLists: 0,
+		},
+		{
+			name:        "wait up to, session ends",
+			mode:        targetSessionHandlingWaitUpTo,
+			timeout:     "10ms",
+			activeLists: 1,
+		},
+		{
+			name:         "wait up to, session outlives the timeout",
+			mode:         targetSessionHandlingWaitUpTo,
+			timeout:      "1ms",
+			activeLists:  100,
+			wantCanceled: []string{"s_1234567890"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &testSessionsController{activeLists: tt.activeLists}
+			m := testTargetSessionHandlingModel(t, tt.mode, tt.timeout)
+
+			err := handleTargetSessions(context.Background(), c.metaData(t), m)
+			if tt.wantError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCanceled, c.canceledSessions())
+		})
+	}
+}
+
+// TestHandleTargetSessionsDeadline checks wait_up_to stops waiting early
+// enough to cancel the sessions before the timeout of the operation
+func TestHandleTargetSessionsDeadline(t *testing.T) {
+	t.Parallel()
+
+	c := &testSessionsController{activeLists: 100}
+	m := testTargetSessionHandlingModel(t, targetSessionHandlingWaitUpTo, "1h")
+	ctx, cancel := context.WithTimeout(context.Background(), targetSessionCancelMargin+100*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, handleTargetSessions(ctx, c.metaData(t), m))
+	assert.Equal(t, []string{"s_1234567890"}, c.canceledSessions())
+	assert.NoError(t, ctx.Err())
+}
+
+// TestResourceTargetUpdateKeepsSessions checks the active sessions are not
+// canceled when the target cannot be updated
+func TestResourceTargetUpdateKeepsSessions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := &testSessionsController{activeLists: 1}
+	r := &targetResource{md: c.metaData(t)}
+	state := testTargetSessionHandlingData(t, targetSessionHandlingCancel, "")
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	require.False(t, plan.SetAttribute(ctx, path.Root(targetAddressKey), "127.0.0.2").HasError())
+
+	resp := resource.UpdateResponse{
+		State:    tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+		Identity: testResourceIdentity(t, r),
+	}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Error updating target", resp.Diagnostics.Errors()[0].Summary())
+	assert.Empty(t, c.canceledSessions())
+
+	// the prior address is kept so the update is made again next time
+	var address string
+	require.False(t, resp.State.GetAttribute(ctx, path.Root(targetAddressKey), &address).HasError())
+	assert.Equal(t, "127.0.0.1", address)
+}
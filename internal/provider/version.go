@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/hashicorp/boundary/api/workers"
+	goversion "github.com/hashicorp/go-version"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const controllerVersionKey = "controller_version"
+
+// versionRequirement is the minimum Boundary version a resource or one of its
+// attributes needs
+type versionRequirement struct {
+	// attribute is empty when the whole resource needs the version
+	attribute string
+	version   string
+}
+
+// resourceVersionRequirements are the Boundary versions needed by resources
+// and attributes that older controllers reject with unclear errors
+var resourceVersionRequirements = map[string][]versionRequirement{
+	"boundary_alias_target":                             {{version: "0.16.0"}},
+	"boundary_alias_target_set":                         {{version: "0.16.0"}},
+	"boundary_credential_username_password_domain":      {{version: "0.19.0"}},
+	"boundary_policy_storage":                           {{version: "0.15.0"}},
+	"boundary_scope_policy_attachment":                  {{version: "0.15.0"}},
+	"boundary_session_recording_reapply_storage_policy": {{version: "0.15.0"}},
+	"boundary_storage_bucket":                           {{version: "0.13.0"}},
+	"boundary_target":                                   targetVersionRequirements,
+	"boundary_target_rdp":                               append([]versionRequirement{{version: "0.19.0"}}, targetVersionRequirements...),
+	"boundary_target_ssh":                               targetVersionRequirements,
+	"boundary_target_tcp":                               targetVersionRequirements,
+}
+
+var targetVersionRequirements = []versionRequirement{
+	{attribute: targetInjectedAppCredentialSourceIdsKey, version: "0.12.0"},
+	{attribute: targetWorkerEgressFilterKey, version: "0.12.0"},
+	{attribute: targetWorkerIngressFilterKey, version: "0.12.0"},
+	{attribute: targetEnableSessionRecordingKey, version: "0.13.0"},
+	{attribute: targetStorageBucketIdKey, version: "0.13.0"},
+}
+
+// dataSourceVersionRequirements are the Boundary versions needed by data
+// sources
+var dataSourceVersionRequirements = map[string]string{
+	"boundary_resolvable_aliases": "0.16.0",
+	"boundary_session_recordings": "0.13.0",
+}
+
+// workerReleaseVersionRegexp extracts the version from a worker release
+// version, e.g. "Boundary v0.16.2+ent"
+var workerReleaseVersionRegexp = regexp.MustCompile(`v?(\d+\.\d+\.\d+)`)
+
+// controllerVersion returns the configured controller version, or detects it
+// from the release version of the workers. Controllers are always upgraded
+// before their workers, so the newest worker gives the oldest version the
+// controllers can be running. Nil is returned when the version is unknown.
+func controllerVersion(ctx context.Context, d *schema.ResourceData, md *metaData) (*goversion.Version, bool, error) {
+	if v, ok := d.GetOk(controllerVersionKey); ok {
+		parsed, err := goversion.NewVersion(v.(string))
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid %q: %w", controllerVersionKey, err)
+		}
+		return parsed, false, nil
+	}
+
+	wlr, err := workers.NewClient(md.client).List(ctx, globalScopeId)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to detect the Boundary version from the workers", map[string]interface{}{"error": err.Error()})
+		return nil, false, nil
+	}
+	var detected *goversion.Version
+	for _, w := range wlr.GetItems() {
+		v := parseWorkerReleaseVersion(w.ReleaseVersion)
+		if v != nil && (detected == nil || v.GreaterThan(detected)) {
+			detected = v
+		}
+	}
+	if detected != nil {
+		tflog.Debug(ctx, "Detected the Boundary version from the workers", map[string]interface{}{"version": detected.String()})
+	}
+	return detected, detected != nil, nil
+}
+
+// parseWorkerReleaseVersion returns the version of a worker release version,
+// or nil if it cannot be parsed
+func parseWorkerReleaseVersion(releaseVersion string) *goversion.Version {
+	m := workerReleaseVersionRegexp.FindStringSubmatch(releaseVersion)
+	if m == nil {
+		return nil
+	}
+	v, err := goversion.NewVersion(m[1])
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// checkVersion reports when the controller version is known and older than
+// required. It is an error when the version was configured, but only a
+// warning when it was detected from the workers as the controllers may
+// already have been upgraded.
+func checkVersion(md *metaData, what, required string) diag.Diagnostics {
+	if md == nil || md.controllerVersion == nil {
+		return nil
+	}
+	minVersion := goversion.Must(goversion.NewVersion(required))
+	if !md.controllerVersion.LessThan(minVersion) {
+		return nil
+	}
+	if md.controllerVersionDetected {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s requires Boundary %s+", what, required),
+			Detail: fmt.Sprintf("The workers report Boundary %s. If the controllers were already upgraded, set %q "+
+				"on the provider to their version to silence this warning.", md.controllerVersion, controllerVersionKey),
+		}}
+	}
+	return diag.Errorf("%s requires Boundary %s+, but %q is %s", what, required, controllerVersionKey, md.controllerVersion)
+}
+
+// versionDiff is the part of *schema.ResourceDiff and *schema.ResourceData
+// the version checks use
+type versionDiff interface {
+	Id() string
+	GetOk(string) (interface{}, bool)
+	HasChange(string) bool
+}
+
+// checkVersionRequirements checks the requirements of the resource against
+// the controller version. Resource requirements only apply when it is
+// created, attribute requirements when the attribute is set or changed.
+func checkVersionRequirements(md *metaData, name string, d versionDiff, reqs []versionRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, req := range reqs {
+		switch {
+		case req.attribute == "":
+			if d.Id() != "" {
+				continue
+			}
+			diags = append(diags, checkVersion(md, name, req.version)...)
+		default:
+			if _, ok := d.GetOk(req.attribute); !ok || !d.HasChange(req.attribute) {
+				continue
+			}
+			diags = append(diags, checkVersion(md, fmt.Sprintf("%q of %s", req.attribute, name), req.version)...)
+		}
+	}
+	return diags
+}
+
+// checkPlanVersionRequirements checks the requirements of a resource written
+// with the plugin framework against its plan, like the SDK resources do in
+// CustomizeDiff. The framework reports the warnings during plan.
+func checkPlanVersionRequirements(md *metaData, name string, req resource.ModifyPlanRequest) fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+	if req.Plan.Raw.IsNull() {
+		// the resource is destroyed
+		return diags
+	}
+	d := planVersionDiff{plan: req.Plan.Raw, state: req.State.Raw}
+	for _, c := range checkVersionRequirements(md, name, d, resourceVersionRequirements[name]) {
+		if c.Severity == diag.Warning {
+			diags.AddWarning(c.Summary, c.Detail)
+		} else {
+			diags.AddError(c.Summary, c.Detail)
+		}
+	}
+	return diags
+}
+
+// planVersionDiff is the versionDiff of the plan of a framework resource
+type planVersionDiff struct {
+	plan, state tftypes.Value
+}
+
+func (d planVersionDiff) Id() string {
+	var id string
+	if v, ok := objectAttribute(d.state, IDKey); ok && v.IsKnown() && !v.IsNull() {
+		_ = v.As(&id)
+	}
+	return id
+}
+
+// GetOk reports whether the planned value of the attribute is known and not
+// a zero value, like ResourceDiff.GetOk does
+func (d planVersionDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := objectAttribute(d.plan, key)
+	if !ok || !v.IsKnown() || v.IsNull() {
+		return nil, false
+	}
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		return s, v.As(&s) == nil && s != ""
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		return b, v.As(&b) == nil && b
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		return n, v.As(n) == nil && n.Sign() != 0
+	case v.Type().Is(tftypes.Set{}), v.Type().Is(tftypes.List{}):
+		var elems []tftypes.Value
+		return elems, v.As(&elems) == nil && len(elems) > 0
+	default:
+		return v, true
+	}
+}
+
+func (d planVersionDiff) HasChange(key string) bool {
+	planned, ok := objectAttribute(d.plan, key)
+	if !ok {
+		return false
+	}
+	prior, ok := objectAttribute(d.state, key)
+	return !ok || !planned.Equal(prior)
+}
+
+// objectAttribute returns the named attribute of an object, it is not found
+// when the object is null or does not have it
+func objectAttribute(obj tftypes.Value, name string) (tftypes.Value, bool) {
+	if obj.IsNull() || !obj.IsKnown() {
+		return tftypes.Value{}, false
+	}
+	var attributes map[string]tftypes.Value
+	if err := obj.As(&attributes); err != nil {
+		return tftypes.Value{}, false
+	}
+	v, ok := attributes[name]
+	return v, ok
+}
+
+// diagnosticsError returns the first error of diags, warnings are ignored
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s", d.Summary)
+		}
+	}
+	return nil
+}
+
+// addVersionRequirements makes the resources and data sources of the provider
+// check their version requirements, during plan for resources and before
+// reading for data sources. CustomizeDiff cannot return warnings, so the
+// warnings of resources are reported when they are created or updated.
+func addVersionRequirements(p *schema.Provider) {
+	for name, reqs := range resourceVersionRequirements {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			continue
+		}
+		name, reqs := name, reqs
+		check := func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			md, _ := meta.(*metaData)
+			return diagnosticsError(checkVersionRequirements(md, name, d, reqs))
+		}
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = check
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(check, r.CustomizeDiff)
+		}
+
+		warn := func(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			md, _ := meta.(*metaData)
+			return checkVersionRequirements(md, name, d, reqs)
+		}
+		r.CreateContext = withVersionWarnings(r.CreateContext, warn)
+		r.CreateWithoutTimeout = withVersionWarnings(r.CreateWithoutTimeout, warn)
+		r.UpdateContext = withVersionWarnings(r.UpdateContext, warn)
+		r.UpdateWithoutTimeout = withVersionWarnings(r.UpdateWithoutTimeout, warn)
+	}
+
+	for name, required := range dataSourceVersionRequirements {
+		r, ok := p.DataSourcesMap[name]
+		if !ok {
+			continue
+		}
+		name, required, read := name, required, r.ReadContext
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			md, _ := meta.(*metaData)
+			diags := checkVersion(md, name, required)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, read(ctx, d, meta)...)
+		}
+	}
+}
+
+// withVersionWarnings returns f reporting the warnings of the version
+// requirements, nil is returned when f is nil. Errors were already reported
+// during plan.
+func withVersionWarnings(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, warn func(*schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		for _, w := range warn(d, meta) {
+			if w.Severity == diag.Warning {
+				diags = append(diags, w)
+			}
+		}
+		return append(diags, f(ctx, d, meta)...)
+	}
+}
+
+// validateVersion checks the value is a version
+func validateVersion(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := goversion.NewVersion(v); err != nil {
+		return nil, []error{fmt.Errorf("%q is not a valid version: %w", k, err)}
+	}
+	return nil, nil
+}
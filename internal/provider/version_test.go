@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkerReleaseVersion(t *testing.T) {
+	t.Parallel()
+
+	for releaseVersion, want := range map[string]string{
+		"Boundary v0.16.2":     "0.16.2",
+		"Boundary v0.18.0+ent": "0.18.0",
+		"0.13.1":               "0.13.1",
+	} {
+		v := parseWorkerReleaseVersion(releaseVersion)
+		require.NotNil(t, v, releaseVersion)
+		assert.Equal(t, want, v.String())
+	}
+	assert.Nil(t, parseWorkerReleaseVersion(""))
+	assert.Nil(t, parseWorkerReleaseVersion("Boundary dev"))
+}
+
+// testVersionDiff is a versionDiff for an attribute that changed to value
+type testVersionDiff struct {
+	id      string
+	changed map[string]interface{}
+}
+
+func (d testVersionDiff) Id() string { return d.id }
+
+func (d testVersionDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := d.changed[key]
+	return v, ok
+}
+
+func (d testVersionDiff) HasChange(key string) bool {
+	_, ok := d.changed[key]
+	return ok
+}
+
+func TestCheckVersionRequirements(t *testing.T) {
+	t.Parallel()
+
+	reqs := []versionRequirement{
+		{version: "0.16.0"},
+		{attribute: targetInjectedAppCredentialSourceIdsKey, version: "0.17.0"},
+	}
+	old := goversion.Must(goversion.NewVersion("0.15.2"))
+	current := goversion.Must(goversion.NewVersion("0.16.0"))
+
+	tests := []struct {
+		name        string
+		md          *metaData
+		diff        testVersionDiff
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name: "unknown version",
+			md:   &metaData{},
+		},
+		{
+			name:    "create on configured older version",
+			md:      &metaData{controllerVersion: old},
+			wantErr: `boundary_foo requires Boundary 0.16.0+, but "controller_version" is 0.15.2`,
+		},
+		{
+			name:        "create on detected older version",
+			md:          &metaData{controllerVersion: old, controllerVersionDetected: true},
+			wantWarning: "boundary_foo requires Boundary 0.16.0+",
+		},
+		{
+			name: "existing resource",
+			md:   &metaData{controllerVersion: old},
+			diff: testVersionDiff{id: "foo_1234"},
+		},
+		{
+			name: "create on recent version",
+			md:   &metaData{controllerVersion: current},
+		},
+		{
+			name: "attribute on older version",
+			md:   &metaData{controllerVersion: current},
+			diff: testVersionDiff{id: "foo_1234", changed: map[string]interface{}{
+				targetInjectedAppCredentialSourceIdsKey: []interface{}{"clvlt_1234"},
+			}},
+			wantErr: `"injected_application_credential_source_ids" of boundary_foo requires Boundary 0.17.0+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			diags := checkVersionRequirements(tt.md, "boundary_foo", tt.diff, reqs)
+			err := diagnosticsError(diags)
+			switch {
+			case tt.wantErr != "":
+				assert.ErrorContains(t, err, tt.wantErr)
+			case tt.wantWarning != "":
+				assert.NoError(t, err)
+				require.Len(t, diags, 1)
+				assert.Equal(t, diag.Warning, diags[0].Severity)
+				assert.Equal(t, tt.wantWarning, diags[0].Summary)
+			default:
+				assert.Empty(t, diags)
+			}
+		})
+	}
+}
+
+func TestVersionRequirementsResources(t *testing.T) {
+	t.Parallel()
+
+	resp, err := testMuxServer(t).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for name := range resourceVersionRequirements {
+		assert.Contains(t, resp.ResourceSchemas, name)
+	}
+	for name := range resp.ResourceSchemas {
+		if name != "boundary_target" && strings.HasPrefix(name, "boundary_target_") && !strings.HasSuffix(name, "_source") {
+			assert.Contains(t, resourceVersionRequirements, name)
+		}
+	}
+}
+
+func TestPlanVersionRequirements(t *testing.T) {
+	t.Parallel()
+
+	old := goversion.Must(goversion.NewVersion("0.11.0"))
+	creds := map[string]tftypes.Value{
+		targetInjectedAppCredentialSourceIdsKey: testStringSetValue("clvlt_1234567890"),
+	}
+
+	tests := []struct {
+		name        string
+		resource    func() resource.Resource
+		md          *metaData
+		state       map[string]tftypes.Value
+		plan        map[string]tftypes.Value
+		destroy     bool
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name:     "attribute set on create",
+			resource: newTargetSshResource,
+			md:       &metaData{controllerVersion: old},
+			plan:     creds,
+			wantErr:  `"injected_application_credential_source_ids" of boundary_target_ssh requires Boundary 0.12.0+, but "controller_version" is 0.11.0`,
+		},
+		{
+			name:     "attribute unchanged",
+			resource: newTargetSshResource,
+			md:       &metaData{controllerVersion: old},
+			state:    creds,
+			plan:     creds,
+		},
+		{
+			name:     "attribute the resource does not have",
+			resource: newTargetTcpResource,
+			md:       &metaData{controllerVersion: old},
+		},
+		{
+			name:        "resource on detected older version",
+			resource:    newTargetRdpResource,
+			md:          &metaData{controllerVersion: goversion.Must(goversion.NewVersion("0.18.2")), controllerVersionDetected: true},
+			wantWarning: "boundary_target_rdp requires Boundary 0.19.0+",
+		},
+		{
+			name:     "destroy",
+			resource: newTargetSshResource,
+			md:       &metaData{controllerVersion: old},
+			state:    creds,
+			destroy:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tt.resource().(*targetResource)
+			r.md = tt.md
+			state := testResourceState(t, r, tt.state)
+			if tt.state == nil {
+				state.Raw = tftypes.NewValue(state.Raw.Type(), nil)
+			}
+			plan := testResourceState(t, r, tt.plan)
+			if tt.destroy {
+				plan.Raw = tftypes.NewValue(plan.Raw.Type(), nil)
+			}
+			var resp resource.ModifyPlanResponse
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+				State: state,
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			}, &resp)
+
+			switch {
+			case tt.wantErr != "":
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.wantErr, resp.Diagnostics.Errors()[0].Summary())
+			case tt.wantWarning != "":
+				require.Len(t, resp.Diagnostics, 1)
+				assert.Equal(t, tt.wantWarning, resp.Diagnostics.Warnings()[0].Summary())
+			default:
+				assert.Empty(t, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestVersionWarnings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p := New()
+	r := p.ResourcesMap["boundary_alias_target"]
+	md := &metaData{
+		controllerVersion:         goversion.Must(goversion.NewVersion("0.15.2")),
+		controllerVersionDetected: true,
+	}
+	create := withVersionWarnings(func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return nil
+	}, func(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return checkVersionRequirements(meta.(*metaData), "boundary_alias_target", d, resourceVersionRequirements["boundary_alias_target"])
+	})
+	diags := create(ctx, r.TestResourceData(), md)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, "The workers report Boundary 0.15.2")
+}
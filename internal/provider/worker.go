@@ -202,14 +202,11 @@ func resourceWorkerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	var versionInt int
-	if versionVal, ok := d.GetOk(version); ok {
-		versionInt = versionVal.(int)
-	}
-
 	if len(opts) > 0 {
 		opts = append(opts, workers.WithAutomaticVersioning(true))
-		_, err := wkr.Update(ctx, d.Id(), uint32(versionInt), opts...)
+		_, err := retryOnVersionConflict(ctx, func() (*workers.WorkerUpdateResult, error) {
+			return wkr.Update(ctx, d.Id(), 0, opts...)
+		})
 		if err != nil {
 			return diag.Errorf("error updating worker: %v", err)
 		}
@@ -234,7 +231,7 @@ func resourceWorkerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	wClient := workers.NewClient(md.client)
 
 	_, err := wClient.Delete(ctx, d.Id())
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		return diag.Errorf("error deleting worker: %v", err)
 	}
 
@@ -69,9 +69,9 @@ func TestWorkerWorkerLed(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckworkerResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckworkerResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -111,9 +111,9 @@ func TestWorkerControllerLed(t *testing.T) {
 
 	var provider *schema.Provider
 	resource.Test(t, resource.TestCase{
-		IsUnitTest:        true,
-		ProviderFactories: providerFactories(&provider),
-		CheckDestroy:      testAccCheckworkerResourceDestroy(t, provider),
+		IsUnitTest:               true,
+		ProtoV5ProviderFactories: providerFactories(&provider),
+		CheckDestroy:             testAccCheckworkerResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
 				// create
@@ -4,6 +4,9 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/hashicorp/terraform-provider-boundary/internal/provider"
 )
@@ -19,5 +22,17 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{ProviderFunc: provider.New})
+	// The resources written with the plugin framework are served next to the
+	// SDK ones through a mux server
+	muxServer, err := provider.NewMuxServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	plugin.Serve(&plugin.ServeOpts{GRPCProviderFunc: muxServer})
+
+	// Serve returns once Terraform is done with the provider, stop the KMS
+	// plugins it may have started for the recovery KMS
+	if err := provider.CleanupKmsPlugins(); err != nil {
+		log.Printf("[ERROR] Error cleaning up kms plugins: %s", err)
+	}
 }